@@ -4,14 +4,20 @@
 package cmd
 
 import (
-	"docwiz/internal/io"
+	"docwiz/internal/cfg"
+	docerr "docwiz/internal/error"
+	"docwiz/internal/git"
 	"docwiz/internal/style"
+	"docwiz/internal/tui"
+	"errors"
+	"io/fs"
 
 	"docwiz/internal/os"
 	"docwiz/internal/template"
 	"fmt"
 
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/caarlos0/log"
@@ -36,6 +42,21 @@ type authorsCmdParameter struct {
 	// specialContributors is a list of individuals who have made significant or
 	// notable contributions to the project, such as funding, mentorship, or key features.
 	specialContributors []string
+
+	// authorsFile is the data file recording the people, it's reused by the next runs.
+	authorsFile string
+
+	// auto proposes the people from the history and CODEOWNERS.
+	auto bool
+
+	// repoPath specifies the path to the Git repository of --auto.
+	repoPath string
+
+	// maintainerShare is the share of the commits (0-1) making a committer a maintainer.
+	maintainerShare float64
+
+	// yes accepts the proposed people without asking.
+	yes bool
 }
 
 var (
@@ -44,43 +65,72 @@ var (
 		Use:   "authors",
 		Short: "Generate an AUTHORS file with maintainers and contributors.",
 		Long: `The 'authors' command generates an AUTHORS file that includes 
-		maintainers, contributors, and special contributors based on the provided details.`,
-		Example: `  docwiz authors -o AUTHORS.md authors -m 'name=Alice,duty="Lead Developer"'
-    -c 'name=Bob,duty="Contributor"' -s 'name=Charlie,duty="Special Contributor"'`,
-		Run: func(cmd *cobra.Command, args []string) {
-			var maintainers, contributors, specialContributors []user
-			log.Info("parsing users")
-			for _, m := range authorsParameter.maintainers {
-				user, err := unmarshalUser(m)
-				if err != nil {
-					log.WithError(err).Fatal("parsing maintainers")
+		maintainers, contributors, and special contributors based on the provided details.
+
+The people are read from the people file (.authors.yaml, or a .json file) when it exists.
+Each person has a name and optionally a kind (individual or organization), emails, a login,
+handles, a role, an org, a homepage, a profile, an avatar, since/until dates and custom
+fields under others. The file is validated before being used, the contributors, security
+and code-of-conduct commands read it too.
+
+With --auto, the maintainers are proposed from CODEOWNERS and the share of the commits, the
+other committers become contributors, and the result is confirmed in a form before being
+saved to the people file. The people given with -m, -c and -s, as a name or a YAML/JSON
+object, are added to the ones of the file.`,
+		Example: `  docwiz authors -o AUTHORS.md -m '{name: Alice, role: "Lead Developer, Reviewer"}'
+    -c Bob -s '{"name": "ACME", "kind": "organization", "homepage": "https://acme.example"}'
+  docwiz authors --authors-file people.json
+  docwiz authors --auto
+  docwiz authors --auto --yes --maintainer-share 0.3`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			authors, err := cfg.LoadAuthors(authorsParameter.authorsFile)
+			if err != nil {
+				if !errors.Is(err, fs.ErrNotExist) {
+					return fileError(err, "fail to read %s", authorsParameter.authorsFile)
 				}
-				log.IncreasePadding()
-				log.Infof("%s, maintainer", user.Name)
-				log.DecreasePadding()
-				maintainers = append(maintainers, user)
+				authors = &cfg.Authors{}
+			} else {
+				log.WithField("path", authorsParameter.authorsFile).Info("reading authors")
 			}
 
-			for _, c := range authorsParameter.contributors {
-				user, err := unmarshalUser(c)
+			if authorsParameter.auto {
+				authors, err = discoverAuthors(authors)
 				if err != nil {
-					log.WithError(err).Fatal("parsing contributors")
+					return err
+				}
+				if dryRun {
+					log.Infof("dry run, %s isn't updated", authorsParameter.authorsFile)
+				} else {
+					log.Infof("updating %s", style.Bold(authorsParameter.authorsFile))
+					if err = authors.Save(authorsParameter.authorsFile); err != nil {
+						return docerr.IO(err, "fail to write %s", authorsParameter.authorsFile)
+					}
 				}
-				log.IncreasePadding()
-				log.Infof("%s, contributors", user.Name)
-				log.DecreasePadding()
-				contributors = append(contributors, user)
 			}
 
-			for _, s := range authorsParameter.specialContributors {
-				user, err := unmarshalUser(s)
+			log.Info("parsing users")
+			for _, flag := range []struct {
+				values []string
+				people *[]cfg.Person
+				kind   string
+			}{
+				{authorsParameter.maintainers, &authors.Maintainers, "maintainer"},
+				{authorsParameter.contributors, &authors.Contributors, "contributor"},
+				{authorsParameter.specialContributors, &authors.SpecialContributors, "special contributor"},
+			} {
+				people, err := parsePeople(flag.kind, flag.values)
 				if err != nil {
-					log.WithError(err).Fatal("parsing special contributors")
+					return err
+				}
+				for _, p := range people {
+					log.IncreasePadding()
+					log.Infof("%s, %s", p.Name, flag.kind)
+					log.DecreasePadding()
+					*flag.people = append(*flag.people, p)
 				}
-				log.IncreasePadding()
-				log.Infof("%s, special contributors", user.Name)
-				log.DecreasePadding()
-				specialContributors = append(specialContributors, user)
+			}
+			if err = authors.Validate(); err != nil {
+				return docerr.User(err, "invalid authors")
 			}
 
 			authrosPath := filepath.Join(os.TemplatePath, "AUTHORS")
@@ -91,47 +141,44 @@ var (
 			tpl := filepath.Join(authrosPath, fmt.Sprintf("%s.tpl", authorsParameter.theme))
 
 			log.Infof("creating %s", authorsParameter.output)
-			output, err := io.NewSafeFile(authorsParameter.output)
+			output, err := createOutput(cmd, authorsParameter.output)
 			if err != nil {
-				log.WithError(err).Fatalf("fail to create file")
+				return err
 			}
-			defer output.Close()
-
-			defer func() {
-				if err := recover(); err != nil {
-					output.Rollback()
-					log.WithError(err.(error)).Fatal("error happen and rollback!")
-				}
-			}()
+			defer discardOutput(output)
 
 			log.WithField("target", tpl).Info("loading template")
 			tmpl, err := template.Default(tpl)
 			if err != nil {
-				log.WithError(err).Fatal("fail to load template")
+				return docerr.Template(err, "fail to load template")
 			}
 
 			log.Info("executing template")
 			log.IncreasePadding()
-			log.WithField("Maintainers", authorsParameter.maintainers).
-				WithField("Contributors", authorsParameter.contributors).
-				WithField("SpecialContributors", authorsParameter.specialContributors).
+			log.WithField("Maintainers", len(authors.Maintainers)).
+				WithField("Contributors", len(authors.Contributors)).
+				WithField("SpecialContributors", len(authors.SpecialContributors)).
 				WithField("License", authorsParameter.license).Info("parameters")
 			log.DecreasePadding()
 			err = tmpl.Execute(output, map[string]any{
-				"Maintainers":         maintainers,
-				"Contributors":        contributors,
-				"SpecialContributors": specialContributors,
+				"Maintainers":         authors.Maintainers,
+				"Contributors":        authors.Contributors,
+				"SpecialContributors": authors.SpecialContributors,
 				"License":             authorsParameter.license,
 			})
 			if err != nil {
-				log.WithError(err).Fatal("fail to execute template")
+				return docerr.Template(err, "fail to execute template")
 			}
 
 			if !authorsParameter.disableCopyright {
 				output.Write(COPYRIGHT)
 			}
 			log.Infof("generating %s", style.Bold(authorsParameter.output))
+			if err = closeOutput(output); err != nil {
+				return err
+			}
 			log.Info("thanks for using docwiz!")
+			return nil
 		},
 	}
 )
@@ -144,85 +191,272 @@ func init() {
 	authorsCmd.PersistentFlags().StringVarP(&authorsParameter.theme, "theme", "t", "default", "Template theme to use for the AUTHORS file")
 	authorsCmd.PersistentFlags().StringVarP(&authorsParameter.output, "output", "o", "AUTHORS.md", "Path to the output authors file")
 	authorsCmd.PersistentFlags().StringVarP(&authorsParameter.license, "license", "L", "", "License type to include in the AUTHORS file (e.g., MIT, Apache)")
-	authorsCmd.PersistentFlags().StringArrayVarP(&authorsParameter.maintainers, "maintainers", "m", []string{}, "List of maintainers in JSON format")
-	authorsCmd.PersistentFlags().StringArrayVarP(&authorsParameter.contributors, "contributors", "c", []string{}, "List of contributors in JSON format")
-	authorsCmd.PersistentFlags().StringArrayVarP(&authorsParameter.specialContributors, "special-contributors", "s", []string{}, "List of special contributors in JSON format")
+	authorsCmd.PersistentFlags().StringArrayVarP(&authorsParameter.maintainers, "maintainers", "m", []string{}, "Maintainer as a name or a YAML/JSON object, e.g. '{name: Alice, role: Lead}'")
+	authorsCmd.PersistentFlags().StringArrayVarP(&authorsParameter.contributors, "contributors", "c", []string{}, "Contributor as a name or a YAML/JSON object")
+	authorsCmd.PersistentFlags().StringArrayVarP(&authorsParameter.specialContributors, "special-contributors", "s", []string{}, "Special contributor as a name or a YAML/JSON object, e.g. '{name: ACME, kind: organization}'")
 	authorsCmd.PersistentFlags().BoolVarP(&authorsParameter.disableCopyright, "disable-copyright", "d", false, "Disable copyright information in the authors")
 	authorsCmd.PersistentFlags().StringVarP(&authorsParameter.language, "language", "l", "en_us", "Set the language for contributing file (e.g. zh_cn)")
-	authorsCmd.PersistentFlags().BoolVarP(&authorsParameter.verbose, "verbose", "v", false, "")
+	authorsCmd.PersistentFlags().StringVar(&authorsParameter.authorsFile, "authors-file", cfg.AuthorsFile, "Path to the people file (YAML or JSON)")
+	authorsCmd.PersistentFlags().BoolVar(&authorsParameter.auto, "auto", false, "Propose the people from the Git history and CODEOWNERS")
+	authorsCmd.PersistentFlags().StringVarP(&authorsParameter.repoPath, "repo", "r", ".", "Path to the target Git repository")
+	authorsCmd.PersistentFlags().Float64Var(&authorsParameter.maintainerShare, "maintainer-share", 0.2, "Share of the commits (0-1) making a committer a maintainer")
+	authorsCmd.PersistentFlags().BoolVarP(&authorsParameter.yes, "yes", "y", false, "Accept the proposed people without asking")
 }
 
-// parse key=value to map
-func unmarshal(v string) (map[string]string, error) {
-	result := make(map[string]string)
-	pairs := strings.Split(v, ",")
-
-	for _, pair := range pairs {
-		kv := strings.SplitN(pair, "=", 2)
-		if len(kv) != 2 {
-			return nil, fmt.Errorf("invalid format: %s", pair)
+// parsePeople parses the people given with the flags of a kind, e.g. -m
+// for the maintainers.
+func parsePeople(kind string, values []string) ([]cfg.Person, error) {
+	var people []cfg.Person
+	for _, v := range values {
+		p, err := cfg.ParsePerson(v)
+		if err != nil {
+			return nil, docerr.User(err, "parsing the %s %s", kind, v)
 		}
+		people = append(people, p)
+	}
+	return people, nil
+}
 
-		key := strings.TrimSpace(kv[0])
-		value := strings.TrimSpace(kv[1])
+// loadPeople reads the people file for the other templates, they go
+// without people when it's missing or invalid.
+func loadPeople(filename string) *cfg.Authors {
+	people, err := cfg.LoadAuthors(filename)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			log.WithError(err).Warnf("fail to read %s", filename)
+		}
+		return &cfg.Authors{}
+	}
+	log.WithField("path", filename).Info("reading people")
+	return people
+}
 
-		if strings.HasPrefix(value, "\"") && strings.HasSuffix(value, "\"") {
-			value = strings.Trim(value, "\"")
+// contacts returns the maintainers still active, the people to reach
+// about the conduct or the security of the project.
+func contacts(people *cfg.Authors) []cfg.Person {
+	var active []cfg.Person
+	for _, p := range people.Maintainers {
+		if p.Active() {
+			active = append(active, p)
 		}
+	}
+	return active
+}
 
-		result[key] = value
+// contactEmail returns the email flag, or the first email of the contacts.
+func contactEmail(email string, contacts []cfg.Person) string {
+	if len(email) != 0 {
+		return email
+	}
+	for _, p := range contacts {
+		if e := p.Email(); len(e) != 0 {
+			return e
+		}
 	}
+	return ""
+}
 
-	return result, nil
+// authorProposal is a person of the history proposed for the AUTHORS file.
+type authorProposal struct {
+	cfg.Person
+
+	// summary explains the proposal, e.g. "12 commits, owns /docs/".
+	summary string
+
+	maintainer bool
+	excluded   bool
 }
 
-func unmarshalUser(v string) (user, error) {
-	u := user{IsIndividual: false, Others: make(map[string]string)}
-	data, err := unmarshal(v)
-	if err != nil {
-		return u, err
-	}
-
-	for k, v := range data {
-		switch k {
-		case "name":
-			u.Name = v
-		case "duty":
-			u.Duty = v
-		case "homepage":
-			u.HomePage = v
-		case "profile":
-			u.Profile = v
-		case "isIndividual":
-			if v == "true" {
-				u.IsIndividual = true
+// proposeAuthors proposes the code owners and the people with at least
+// share of the commits as maintainers, the other committers as contributors.
+func proposeAuthors(stats []git.ContributorStats, owners *git.CodeOwners, share float64) []authorProposal {
+	total := 0
+	for _, s := range stats {
+		total += s.Commits
+	}
+
+	var proposals []authorProposal
+	for _, s := range stats {
+		p := authorProposal{Person: cfg.Person{
+			Name:    s.Name,
+			Login:   s.Login,
+			Profile: s.URL,
+			Avatar:  s.Avatar,
+		}}
+		if len(s.Email) != 0 {
+			p.Emails = []string{s.Email}
+		}
+		owned := owners.Owned(s.Identity)
+		ratio := float64(s.Commits) / float64(max(total, 1))
+		p.maintainer = len(owned) != 0 || ratio >= share
+
+		p.summary = fmt.Sprintf("%d commits", s.Commits)
+		switch {
+		case len(owned) != 0:
+			p.summary += ", owns " + joinFirst(owned, 3)
+			p.Role = "owns " + joinFirst(owned, 3)
+		case p.maintainer:
+			p.summary += fmt.Sprintf(", %.0f%% of the history", ratio*100)
+		}
+		if len(p.Role) == 0 {
+			switch {
+			case p.maintainer && len(s.Areas) != 0:
+				p.Role = "works on " + strings.Join(s.Areas, ", ")
+			case p.maintainer:
+				p.Role = "Core Developer"
+			case len(s.Areas) != 0:
+				p.Role = "Contributed to " + strings.Join(s.Areas, ", ")
+			default:
+				p.Role = "Contributor"
 			}
+		}
+		proposals = append(proposals, p)
+	}
+	return proposals
+}
+
+// joinFirst joins the first n values, the others are summed up as "...".
+func joinFirst(values []string, n int) string {
+	if len(values) > n {
+		return strings.Join(values[:n], ", ") + ", ..."
+	}
+	return strings.Join(values, ", ")
+}
+
+// mergeAuthors replaces the proposals with the people of the data file,
+// so that the choices and the edits of the previous runs are kept. The
+// special contributors are left out, they keep their own section.
+func mergeAuthors(saved *cfg.Authors, proposals []authorProposal) []authorProposal {
+	proposals = slices.DeleteFunc(proposals, func(p authorProposal) bool { return isSpecialContributor(saved, p.Person) })
+	for i := range proposals {
+		p := &proposals[i]
+		if saved.Excludes(p.Key()) {
+			p.excluded = true
+			continue
+		}
+		a := saved.Find(p.Email(), p.Login, p.Name)
+		if a == nil {
+			continue
+		}
+		p.Person = *a
+		p.maintainer = slices.ContainsFunc(saved.Maintainers, func(m cfg.Person) bool { return m.Key() == a.Key() })
+	}
+	return proposals
+}
+
+// applyAuthors returns the data file with the chosen people, the people
+// added by hand to the file are kept after them.
+func applyAuthors(saved *cfg.Authors, proposals []authorProposal) *cfg.Authors {
+	authors := &cfg.Authors{SpecialContributors: saved.SpecialContributors}
+	proposed := make(map[string]struct{})
+	excluded := make(map[string]struct{})
+	for _, p := range proposals {
+		if isSpecialContributor(saved, p.Person) {
+			continue
+		}
+		proposed[p.Key()] = struct{}{}
+		switch {
+		case p.excluded:
+			excluded[p.Key()] = struct{}{}
+			authors.Excluded = append(authors.Excluded, p.Key())
+		case p.maintainer:
+			authors.Maintainers = append(authors.Maintainers, p.Person)
 		default:
-			u.Others[k] = v
+			authors.Contributors = append(authors.Contributors, p.Person)
+		}
+	}
+
+	for _, a := range saved.Maintainers {
+		if _, ok := proposed[a.Key()]; !ok {
+			authors.Maintainers = append(authors.Maintainers, a)
+		}
+	}
+	for _, a := range saved.Contributors {
+		if _, ok := proposed[a.Key()]; !ok {
+			authors.Contributors = append(authors.Contributors, a)
+		}
+	}
+	for _, key := range saved.Excluded {
+		if _, ok := proposed[key]; !ok {
+			authors.Excluded = append(authors.Excluded, key)
 		}
 	}
-	return u, nil
+	return authors
 }
 
-// user represents an individual or an entity that is a contributor or maintainer in the project.
-// It includes personal information such as name, duty, and profile, and allows for customization
-// with additional fields like homepage and other metadata.
-type user struct {
-	// Name holds the name of the user (contributor or maintainer).
-	Name string
+// isSpecialContributor reports whether p is one of the special contributors of saved.
+func isSpecialContributor(saved *cfg.Authors, p cfg.Person) bool {
+	special := &cfg.Authors{SpecialContributors: saved.SpecialContributors}
+	return special.Find(p.Email(), p.Login, p.Name) != nil
+}
 
-	// Duty represents the role or responsibility of the user in the project (e.g., "Lead Developer").
-	Duty string
+// discoverAuthors proposes the people of the repository and lets the user
+// confirm them, unless --yes is set.
+func discoverAuthors(saved *cfg.Authors) (*cfg.Authors, error) {
+	log.WithField("path", authorsParameter.repoPath).Info("parsing .git directory")
+	r, err := git.New(authorsParameter.repoPath)
+	if err != nil {
+		return nil, docerr.Git(err, "fail to read git repository")
+	}
 
-	// HomePage is a link to the user's personal or project website.
-	HomePage string
+	conf, err := cfg.LoadDocWizConfig(cfg.DocWizConfigFile)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		log.WithError(err).Warn("using the default identities")
+	}
 
-	// Profile contains the link to the user's profile, like a GitHub or LinkedIn profile.
-	Profile string
+	stats, err := r.Contributors(git.ContributorsOptions{Identities: identityOptions(conf.Identity)})
+	if err != nil {
+		return nil, docerr.Git(err, "fail to read the commit history")
+	}
 
-	// IsIndividual indicates whether the user is an individual (true) or an organization/entity (false).
-	IsIndividual bool
+	owners, err := r.CodeOwners()
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		owners = &git.CodeOwners{}
+		log.Info("no CODEOWNERS, proposing the maintainers from the history")
+	case err != nil:
+		return nil, docerr.IO(err, "fail to read CODEOWNERS")
+	default:
+		log.WithField("path", owners.Path).Info("reading code owners")
+		warnUnknownOwners(owners, stats)
+	}
 
-	// Others holds any additional metadata about the user that doesn't fit into the predefined fields.
-	Others map[string]string
+	proposals := mergeAuthors(saved, proposeAuthors(stats, owners, authorsParameter.maintainerShare))
+	if !authorsParameter.yes && len(proposals) != 0 {
+		var candidates []tui.AuthorCandidate
+		for _, p := range proposals {
+			candidates = append(candidates, tui.AuthorCandidate{
+				Name:       p.Name,
+				Summary:    p.summary,
+				Role:       p.Role,
+				Maintainer: p.maintainer,
+				Excluded:   p.excluded,
+			})
+		}
+		m := tui.NewAuthorsModel(candidates)
+		if err = m.Run(); err != nil {
+			return nil, docerr.Internal(err, "fail to run the authors form")
+		}
+		if !m.Confirmed() {
+			return nil, docerr.Canceled("aborted, the authors weren't saved")
+		}
+		for i, c := range m.Value() {
+			proposals[i].Role = c.Role
+			proposals[i].maintainer = c.Maintainer
+			proposals[i].excluded = c.Excluded
+		}
+	}
+	return applyAuthors(saved, proposals), nil
+}
+
+// warnUnknownOwners reports the code owners no committer is known to be,
+// their emails should be mapped to their logins in the identity section.
+func warnUnknownOwners(owners *git.CodeOwners, stats []git.ContributorStats) {
+	var people []git.Identity
+	for _, s := range stats {
+		people = append(people, s.Identity)
+	}
+	for _, owner := range owners.Unmatched(people) {
+		log.WithField("owner", owner).Warn("no committer matches the code owner, map its email in the identity.logins of .docwiz.yaml")
+	}
 }
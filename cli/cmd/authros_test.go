@@ -4,37 +4,81 @@
 package cmd
 
 import (
+	"docwiz/internal/cfg"
+	docerr "docwiz/internal/error"
+	"docwiz/internal/git"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 )
 
-func TestUnmarshal(t *testing.T) {
-	testCases := []struct {
-		input    string
-		expected map[string]string
-	}{
-		{
-			input: `name=Alice,duty="Lead Developer",email="123456@gmail.com"`,
-			expected: map[string]string{
-				"name":  "Alice",
-				"duty":  "Lead Developer",
-				"email": "123456@gmail.com",
-			},
-		},
-		{
-			input: `name=Bob, duty="Contributor", homepage="git@github.com/bob.git"`,
-			expected: map[string]string{
-				"name":     "Bob",
-				"duty":     "Contributor",
-				"homepage": "git@github.com/bob.git",
-			},
-		},
+func TestProposeAuthors(t *testing.T) {
+	stats := []git.ContributorStats{
+		{Identity: git.Identity{Name: "Alice", Email: "alice@example.com"}, Commits: 12, Areas: []string{"cli", "internal"}},
+		{Identity: git.Identity{Name: "Bob", Email: "bob@example.com", Login: "bob"}, Commits: 5, Areas: []string{"docs"}},
+		{Identity: git.Identity{Name: "Carol", Email: "carol@example.com"}, Commits: 3},
 	}
+	owners := git.ParseCodeOwners(strings.NewReader("/docs/ @bob\n"))
 
-	for _, tc := range testCases {
-		result, err := unmarshal(tc.input)
-		assert.Equal(t, nil, err)
-		assert.Equal(t, tc.expected, result, "Failed on input: %s", tc.input)
+	proposals := proposeAuthors(stats, owners, 0.5)
+	assert.Len(t, proposals, 3)
+	assert.True(t, proposals[0].maintainer)
+	assert.Equal(t, "works on cli, internal", proposals[0].Role)
+	assert.Equal(t, "12 commits, 60% of the history", proposals[0].summary)
+	assert.True(t, proposals[1].maintainer)
+	assert.Equal(t, "owns /docs/", proposals[1].Role)
+	assert.False(t, proposals[2].maintainer)
+	assert.Equal(t, "Contributor", proposals[2].Role)
+
+	saved := &cfg.Authors{
+		Maintainers:  []cfg.Person{{Name: "Dave", Role: "Founder"}},
+		Contributors: []cfg.Person{{Name: "Alice", Emails: []string{"alice@example.com"}, Role: "Release Manager"}},
+		Excluded:     []string{"carol@example.com", "erin@example.com"},
 	}
+	proposals = mergeAuthors(saved, proposals)
+	assert.False(t, proposals[0].maintainer)
+	assert.Equal(t, "Release Manager", proposals[0].Role)
+	assert.True(t, proposals[2].excluded)
+
+	authors := applyAuthors(saved, proposals)
+	assert.Equal(t, []string{"Bob", "Dave"}, authorNames(authors.Maintainers))
+	assert.Equal(t, []string{"Alice"}, authorNames(authors.Contributors))
+	assert.Equal(t, []string{"carol@example.com", "erin@example.com"}, authors.Excluded)
+}
+
+func TestSpecialContributors(t *testing.T) {
+	stats := []git.ContributorStats{
+		{Identity: git.Identity{Name: "Alice", Email: "alice@example.com"}, Commits: 12},
+		{Identity: git.Identity{Name: "Bob", Email: "bob@example.com"}, Commits: 1},
+	}
+	saved := &cfg.Authors{SpecialContributors: []cfg.Person{{Name: "Bob", Emails: []string{"bob@example.com"}, Role: "Logo"}}}
+
+	proposals := mergeAuthors(saved, proposeAuthors(stats, &git.CodeOwners{}, 0.5))
+	assert.Len(t, proposals, 1)
+	assert.Equal(t, "Alice", proposals[0].Name)
+
+	authors := applyAuthors(saved, proposeAuthors(stats, &git.CodeOwners{}, 0.5))
+	assert.Equal(t, []string{"Alice"}, authorNames(authors.Maintainers))
+	assert.Empty(t, authors.Contributors)
+	assert.Equal(t, []string{"Bob"}, authorNames(authors.SpecialContributors))
+}
+
+func authorNames(authors []cfg.Person) []string {
+	var names []string
+	for _, a := range authors {
+		names = append(names, a.Name)
+	}
+	return names
+}
+
+func TestParsePeople(t *testing.T) {
+	people, err := parsePeople("maintainer", []string{`{name: Alice, role: "Lead Developer, Reviewer"}`, "Bob"})
+	assert.NoError(t, err)
+	assert.Equal(t, []cfg.Person{{Name: "Alice", Role: "Lead Developer, Reviewer"}, {Name: "Bob"}}, people)
+
+	// the former key=value syntax isn't taken as a name
+	_, err = parsePeople("maintainer", []string{`name=Alice,duty="Lead Developer"`})
+	assert.ErrorContains(t, err, "key=value syntax is no longer supported")
+	assert.Equal(t, docerr.KindUser, docerr.KindOf(err))
 }
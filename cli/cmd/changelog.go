@@ -4,9 +4,20 @@
 package cmd
 
 import (
+	"docwiz/internal/cfg"
+	"docwiz/internal/commit"
+	docerr "docwiz/internal/error"
 	"docwiz/internal/git"
 	"docwiz/internal/io"
+	"docwiz/internal/os"
 	"docwiz/internal/style"
+	"docwiz/internal/template"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"strings"
 
 	"github.com/caarlos0/log"
 	"github.com/spf13/cobra"
@@ -18,6 +29,17 @@ type changelogCmdParameter struct {
 	// repoPath specifies the path to the Git repository, from which information like tags will be gathered.
 	// The default value is the current directory ("./").
 	repoPath string
+
+	// incremental inserts the new releases at the top of the existing changelog
+	// instead of regenerating it, hand-edited releases are preserved.
+	incremental bool
+
+	tagPattern      string
+	skipPrereleases bool
+	firstParent     bool
+
+	// next names the unreleased commits after the next version.
+	next bool
 }
 
 var (
@@ -27,38 +49,105 @@ var (
 		Short: "Generate a changelog from the Git repository history.",
 		Long:  "The 'changelog' command analyzes the commit history of a Git repository and generates a changelog file based on the commits and tags.",
 		Example: `  docwiz changelog -o CHANGELOG.md -r /path/to/repo
-  docwiz changelog --output my_changelog.md --repository .`,
-		Run: func(cmd *cobra.Command, args []string) {
-			log.Infof("creating %s", changelogParameter.output)
-			output, err := io.NewSafeFile(changelogParameter.output)
+  docwiz changelog --output my_changelog.md --repository .
+  docwiz changelog --incremental -t keepachangelog
+  docwiz changelog --tag-pattern "api/v*" --first-parent
+  docwiz changelog --incremental --next`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			log.WithField("path", changelogParameter.repoPath).Info("parsing .git directory")
+			r, err := git.New(changelogParameter.repoPath)
 			if err != nil {
-				log.WithError(err).Fatalf("fail to create file")
+				return docerr.Git(err, "fail to read git repository")
+			}
+
+			conf, err := cfg.LoadDocWizConfig(cfg.DocWizConfigFile)
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				log.WithError(err).Warn("using the default changelog sections")
 			}
-			defer output.Close()
 
-			defer func() {
-				if err := recover(); err != nil {
-					output.Rollback()
-					log.WithError(err.(error)).Fatal("error happen and rollback!")
+			opts := git.ChangelogOptions{
+				Classifier: newClassifier(conf.Changelog),
+				Tags: git.TagOptions{
+					Pattern:         conf.Changelog.TagPattern,
+					SkipPrereleases: conf.Changelog.SkipPrereleases,
+				},
+				FirstParent: conf.Changelog.FirstParent,
+			}
+			if cmd.Flags().Changed("tag-pattern") {
+				opts.Tags.Pattern = changelogParameter.tagPattern
+			}
+			if cmd.Flags().Changed("skip-prereleases") {
+				opts.Tags.SkipPrereleases = changelogParameter.skipPrereleases
+			}
+			if cmd.Flags().Changed("first-parent") {
+				opts.FirstParent = changelogParameter.firstParent
+			}
+
+			changelog, err := r.Changelog(opts)
+			if err != nil {
+				return docerr.Git(err, "fail to read the commit history")
+			}
+
+			if changelogParameter.next && len(changelog.Releases) != 0 && changelog.Releases[0].Unreleased {
+				tag, err := nextVersionTag(r, conf.Changelog, "")
+				if err != nil {
+					return docerr.Git(err, "fail to compute the next version")
+				}
+				if len(tag) != 0 {
+					log.WithField("version", tag).Info("naming the unreleased commits")
+					changelog.Releases[0] = r.NameRelease(changelog.Releases[0], tag)
 				}
-			}()
+			}
 
-			log.WithField("path", changelogParameter.repoPath).Info("parsing .git directory")
-			r, err := git.New(changelogParameter.repoPath)
+			changelogPath := filepath.Join(os.TemplatePath, "CHANGELOG")
+			if changelogParameter.language != defaultLanguage {
+				changelogPath = filepath.Join(changelogPath, changelogParameter.language)
+			}
+			tpl := filepath.Join(changelogPath, fmt.Sprintf("%s.tpl", changelogParameter.theme))
+
+			log.WithField("target", tpl).Info("loading template")
+			tmpl, err := template.Default(tpl)
+			if err != nil {
+				return docerr.Template(err, "fail to load template")
+			}
+
+			if changelogParameter.incremental {
+				if ok, _ := io.Exist(changelogParameter.output); ok {
+					log.Infof("updating %s", style.Bold(changelogParameter.output))
+					doc, err := updateChangelog(tmpl, changelog)
+					if err != nil {
+						return err
+					}
+					if err = writeOutput(cmd, changelogParameter.output, []byte(doc), updateOptions(cmd, changelogParameter.output)); err != nil {
+						return err
+					}
+					log.Info("thanks for using docwiz!")
+					return nil
+				}
+				log.Infof("%s doesn't exist, generating the full history", changelogParameter.output)
+			}
+
+			log.Infof("creating %s", changelogParameter.output)
+			output, err := createOutput(cmd, changelogParameter.output)
 			if err != nil {
-				log.WithError(err).Fatal("fail to read git repository")
+				return err
 			}
+			defer discardOutput(output)
 
 			log.Infof("generating %s", style.Bold(changelogParameter.output))
-			err = r.GenerateChangelog(output)
+			err = tmpl.Execute(output, changelogData(changelog, changelog.Releases))
 			if err != nil {
-				log.WithError(err).Fatal("fail to generate changelog")
+				return docerr.Template(err, "fail to execute template")
 			}
 
 			if !changelogParameter.disableCopyright {
 				output.Write(COPYRIGHT)
 			}
+			if err = closeOutput(output); err != nil {
+				return err
+			}
 			log.Info("thanks for using docwiz!")
+			return nil
 		},
 	}
 )
@@ -67,5 +156,108 @@ func init() {
 	docwizCmd.AddCommand(changelogCmd)
 	changelogCmd.PersistentFlags().StringVarP(&changelogParameter.output, "output", "o", "CHANGELOG.md", "Path to the output changelog file")
 	changelogCmd.PersistentFlags().StringVarP(&changelogParameter.repoPath, "repository", "r", ".", "Path to the target Git repository")
+	changelogCmd.PersistentFlags().StringVarP(&changelogParameter.theme, "theme", "t", "default", "Theme of the changelog template (default, keepachangelog, compact)")
+	changelogCmd.PersistentFlags().StringVarP(&changelogParameter.language, "language", "l", "en_us", "Set the language for changelog file (e.g. zh_cn)")
+	changelogCmd.PersistentFlags().BoolVarP(&changelogParameter.incremental, "incremental", "i", false, "Only add the new releases to the existing changelog")
+	changelogCmd.PersistentFlags().StringVar(&changelogParameter.tagPattern, "tag-pattern", "", "Only use the tags matching the glob as releases (e.g. api/v*)")
+	changelogCmd.PersistentFlags().BoolVar(&changelogParameter.skipPrereleases, "skip-prereleases", false, "Merge prerelease tags into the next stable release")
+	changelogCmd.PersistentFlags().BoolVar(&changelogParameter.firstParent, "first-parent", false, "Follow the first parent of merges and list merged pull requests by title")
+	changelogCmd.PersistentFlags().BoolVar(&changelogParameter.next, "next", false, "Name the unreleased commits after the next version")
 	changelogCmd.PersistentFlags().BoolVarP(&changelogParameter.disableCopyright, "disable-copyright", "d", false, "Disable copyright information in the changelog")
 }
+
+// newClassifier builds the commit classifier from the changelog configuration.
+func newClassifier(conf cfg.ChangelogConfig) *commit.Classifier {
+	classifier := commit.DefaultClassifier()
+	if len(conf.Sections) != 0 {
+		classifier.Sections = nil
+		for _, s := range conf.Sections {
+			classifier.Sections = append(classifier.Sections, commit.Section{Title: s.Title, Types: s.Types})
+		}
+	}
+	if conf.Hidden != nil {
+		classifier.Hidden = conf.Hidden
+	}
+	if conf.Breaking != nil {
+		classifier.Breaking = *conf.Breaking
+	}
+	if conf.Other != nil {
+		classifier.Other = *conf.Other
+	}
+	return classifier
+}
+
+func changelogData(changelog *git.Changelog, releases []git.Release) map[string]any {
+	return map[string]any{
+		"ProjectName":   changelog.Name,
+		"ProjectOwner":  changelog.Owner,
+		"RepositoryURL": changelog.URL,
+		"Releases":      releases,
+	}
+}
+
+// releaseHeadingRegex extracts the release name of a changelog heading,
+// e.g. "## v1.0.0", "## [v1.0.0] - 2025-01-01" or "## [Unreleased]".
+var releaseHeadingRegex = regexp.MustCompile(`^##\s+\[?([^\]\s]+)\]?`)
+
+func releaseOf(heading string) string {
+	if m := releaseHeadingRegex.FindStringSubmatch(heading); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// isUnreleased matches the unreleased headings of the built-in templates.
+func isUnreleased(name string) bool {
+	return strings.EqualFold(name, "Unreleased") || name == "未发布"
+}
+
+// updateChangelog renders the releases missing from the existing changelog
+// and the unreleased commits, then returns the changelog with them spliced
+// at the top. The errors are typed, a read failure is an IO error.
+func updateChangelog(tmpl *template.DocWizTemplate, changelog *git.Changelog) (string, error) {
+	data, err := io.ReadText(changelogParameter.output)
+	if err != nil {
+		return "", docerr.IO(err, "fail to read %s", changelogParameter.output)
+	}
+
+	existing := make(map[string]struct{})
+	for _, s := range io.ParseMarkdown(data).Sections {
+		existing[releaseOf(s.Heading)] = struct{}{}
+	}
+
+	var releases []git.Release
+	for _, release := range changelog.Releases {
+		if _, ok := existing[release.Name]; !ok || release.Unreleased {
+			releases = append(releases, release)
+		}
+	}
+
+	var generated strings.Builder
+	if err := tmpl.Execute(&generated, changelogData(changelog, releases)); err != nil {
+		return "", docerr.Template(err, "fail to update changelog")
+	}
+	if len(releases) != 0 && len(io.ParseMarkdown(generated.String()).Sections) == 0 {
+		err := fmt.Errorf("the %s theme has no release headings to update", changelogParameter.theme)
+		return "", docerr.Template(err, "fail to update changelog")
+	}
+	log.WithField("releases", len(releases)).Info("new releases")
+
+	footer := ""
+	if !changelogParameter.disableCopyright {
+		footer = string(COPYRIGHT)
+	}
+	doc := io.SpliceMarkdownText(data, generated.String(), footer, func(heading string) bool {
+		name := releaseOf(heading)
+		if isUnreleased(name) {
+			return true
+		}
+		for _, release := range releases {
+			if release.Name == name {
+				return true
+			}
+		}
+		return false
+	})
+	return doc, nil
+}
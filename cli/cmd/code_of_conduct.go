@@ -4,7 +4,8 @@
 package cmd
 
 import (
-	"docwiz/internal/io"
+	"docwiz/internal/cfg"
+	docerr "docwiz/internal/error"
 	"docwiz/internal/style"
 
 	"docwiz/internal/os"
@@ -20,6 +21,9 @@ import (
 type CodeOfConductCmdParameter struct {
 	baseParameter
 	email string
+
+	// authorsFile is the people file, its maintainers are the contacts of the reports.
+	authorsFile string
 }
 
 var (
@@ -29,9 +33,10 @@ var (
 		Aliases: []string{"coc"},
 		Short:   "Generate a code of conduct for your project",
 		Long: `The 'conduct' command generates a code of conduct file for your project, 
-which includes guidelines for respectful behavior, inclusivity, and maintaining a positive community environment.`,
+which includes guidelines for respectful behavior, inclusivity, and maintaining a positive community environment.
+The active maintainers of the people file (.authors.yaml) are listed as the contacts of the reports.`,
 		Example: "  docwiz conduct",
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			conductPath := filepath.Join(os.TemplatePath, "CODE_OF_CONDUCT")
 
 			tpl := filepath.Join(conductPath, fmt.Sprintf("%s.tpl", conductParameter.theme))
@@ -40,38 +45,37 @@ which includes guidelines for respectful behavior, inclusivity, and maintaining
 			}
 
 			log.Infof("creating %s", authorsParameter.output)
-			output, err := io.NewSafeFile(conductParameter.output)
+			output, err := createOutput(cmd, conductParameter.output)
 			if err != nil {
-				log.WithError(err).Fatalf("fail to create file")
+				return err
 			}
-			defer output.Close()
-
-			defer func() {
-				if err := recover(); err != nil {
-					output.Rollback()
-					log.WithError(err.(error)).Fatal("error happen and rollback!")
-				}
-			}()
+			defer discardOutput(output)
 
 			log.WithField("target", tpl).Info("loading template")
 			tmpl, err := template.Default(tpl)
 			if err != nil {
-				log.WithError(err).Fatal("fail to load template")
+				return docerr.Template(err, "fail to load template")
 			}
 
+			people := contacts(loadPeople(conductParameter.authorsFile))
 			log.Info("executing template")
 			err = tmpl.Execute(output, map[string]any{
-				"Email": conductParameter.email,
+				"Email":    contactEmail(conductParameter.email, people),
+				"Contacts": people,
 			})
 			if err != nil {
-				log.WithError(err).Fatal("fail to execute template")
+				return docerr.Template(err, "fail to execute template")
 			}
 
 			if conductParameter.disableCopyright {
 				output.Write([]byte(COPYRIGHT))
 			}
 			log.Infof("generating %s", style.Bold(conductParameter.output))
+			if err = closeOutput(output); err != nil {
+				return err
+			}
 			log.Info("thanks for using docwiz!")
+			return nil
 		},
 	}
 )
@@ -82,5 +86,6 @@ func init() {
 	conductCmd.PersistentFlags().StringVarP(&conductParameter.theme, "theme", "t", "default", "Theme for the conduct template")
 	conductCmd.PersistentFlags().BoolVarP(&conductParameter.disableCopyright, "disable-copyright", "d", false, "Disable copyright information in the conduct")
 	conductCmd.PersistentFlags().StringVarP(&conductParameter.email, "email", "e", "", "Email to contact and report issues")
+	conductCmd.PersistentFlags().StringVar(&conductParameter.authorsFile, "authors-file", cfg.AuthorsFile, "Path to the people file listing the contacts")
 	conductCmd.PersistentFlags().StringVarP(&conductParameter.language, "language", "l", "en_us", "Set the language for contributing file (e.g. zh_cn)")
 }
@@ -0,0 +1,191 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package cmd
+
+import (
+	"docwiz/internal/cfg"
+	docerr "docwiz/internal/error"
+	"docwiz/internal/git"
+	"docwiz/internal/io"
+	"docwiz/internal/style"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/caarlos0/log"
+	"github.com/spf13/cobra"
+)
+
+// codeownersCmdParameter stores parameters for the "codeowners" command.
+type codeownersCmdParameter struct {
+	baseParameter
+
+	// repoPath specifies the path to the Git repository.
+	repoPath string
+
+	threshold   float64
+	minOwners   int
+	maxOwners   int
+	depth       int
+	days        int
+	blameWeight float64
+}
+
+// codeownersRegion delimits the generated rules, the rules written
+// around it survive the next runs.
+var codeownersRegion = io.HashRegion("codeowners")
+
+// codeownersHeader explains the generated rules.
+const codeownersHeader = `# Generated by "docwiz codeowners" from the Git history, this block is
+# rewritten by the next runs. Pin rules in the codeowners section of
+# .docwiz.yaml or write them below this block, the last matching rule wins.
+`
+
+var (
+	codeownersParameter codeownersCmdParameter
+	codeownersCmd       = &cobra.Command{
+		Use:   "codeowners",
+		Short: "Generate a CODEOWNERS file from the ownership of the Git history",
+		Long: `The 'codeowners' command computes the owners of every directory from the share
+of the recent commits and, with a blame weight, of the lines they authored. The people
+above the threshold are written as their forge username, their email when the username
+is unknown (map it in identity.logins), or their team. The files of .docwizignore are
+left out.
+
+The rules are written to a docwiz block of the CODEOWNERS file, which is compatible with
+GitHub and GitLab. The rules written outside the block and the pinned rules of
+.docwiz.yaml survive the regeneration.`,
+		Example: `  docwiz codeowners
+  docwiz codeowners --threshold 0.3 --max-owners 2 --depth 1
+  docwiz codeowners --days 0 --blame-weight 0 -o .github/CODEOWNERS`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			log.WithField("path", codeownersParameter.repoPath).Info("parsing .git directory")
+			r, err := git.New(codeownersParameter.repoPath)
+			if err != nil {
+				return docerr.Git(err, "fail to read git repository")
+			}
+
+			conf, err := cfg.LoadDocWizConfig(cfg.DocWizConfigFile)
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				log.WithError(err).Warn("using the default code owners settings")
+			}
+			settings := conf.CodeOwners
+			if cmd.Flags().Changed("threshold") {
+				settings.Threshold = codeownersParameter.threshold
+			}
+			if cmd.Flags().Changed("min-owners") {
+				settings.MinOwners = codeownersParameter.minOwners
+			}
+			if cmd.Flags().Changed("max-owners") {
+				settings.MaxOwners = codeownersParameter.maxOwners
+			}
+			if cmd.Flags().Changed("depth") {
+				settings.Depth = codeownersParameter.depth
+			}
+			if cmd.Flags().Changed("days") {
+				settings.Days = codeownersParameter.days
+			}
+			if cmd.Flags().Changed("blame-weight") {
+				settings.BlameWeight = codeownersParameter.blameWeight
+			}
+
+			ignore, err := cfg.LoadDocWizIgnore(filepath.Join(codeownersParameter.repoPath, ".docwizignore"))
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				log.WithError(err).Warn("fail to read .docwizignore")
+			}
+
+			opts := git.OwnershipOptions{
+				Identities:  identityOptions(conf.Identity),
+				Depth:       settings.Depth,
+				BlameWeight: settings.BlameWeight,
+				Ignore:      ignore.Git.MatchesPath,
+			}
+			if settings.Days > 0 {
+				opts.Since = time.Now().AddDate(0, 0, -settings.Days)
+			}
+			log.WithField("blame", settings.BlameWeight > 0).Info("computing the ownership")
+			ownership, err := r.Ownership(opts)
+			if err != nil {
+				return docerr.Git(err, "fail to read the commit history")
+			}
+
+			codeowners := git.GenerateCodeOwners(ownership, codeOwnersOptions(settings))
+			log.IncreasePadding()
+			for _, rule := range codeowners.Rules {
+				log.Infof("%s %s", rule.Pattern, strings.Join(rule.Owners, " "))
+			}
+			log.DecreasePadding()
+
+			output := codeownersParameter.output
+			if len(output) == 0 {
+				output = codeownersPath(r)
+			}
+			var doc string
+			if data, err := os.ReadFile(output); err == nil {
+				log.Infof("updating %s", style.Bold(output))
+				doc = string(data)
+			} else if errors.Is(err, fs.ErrNotExist) {
+				log.Infof("creating %s", style.Bold(output))
+			} else {
+				return docerr.IO(err, "reading %s", output)
+			}
+
+			doc = codeownersRegion.Replace(doc, codeownersHeader+codeowners.String(), false)
+			if output != io.Stdout && !dryRun {
+				if err = os.MkdirAll(filepath.Dir(output), 0755); err != nil {
+					return docerr.IO(err, "creating %s", filepath.Dir(output))
+				}
+			}
+			if err = writeOutput(cmd, output, []byte(doc), updateOptions(cmd, output)); err != nil {
+				return err
+			}
+			log.Info("thanks for using docwiz!")
+			return nil
+		},
+	}
+)
+
+func init() {
+	docwizCmd.AddCommand(codeownersCmd)
+	codeownersCmd.PersistentFlags().StringVarP(&codeownersParameter.output, "output", "o", "", "Path to the CODEOWNERS file (default: the existing one, or the location of the forge)")
+	codeownersCmd.PersistentFlags().StringVarP(&codeownersParameter.repoPath, "repo", "r", ".", "Path to the target Git repository")
+	codeownersCmd.PersistentFlags().Float64Var(&codeownersParameter.threshold, "threshold", 0.2, "Minimum share (0-1) of a directory making a person an owner")
+	codeownersCmd.PersistentFlags().IntVar(&codeownersParameter.minOwners, "min-owners", 1, "Minimum number of owners per directory")
+	codeownersCmd.PersistentFlags().IntVar(&codeownersParameter.maxOwners, "max-owners", 3, "Maximum number of owners per directory, 0 means unlimited")
+	codeownersCmd.PersistentFlags().IntVar(&codeownersParameter.depth, "depth", 2, "Depth of the directories getting their own rule")
+	codeownersCmd.PersistentFlags().IntVar(&codeownersParameter.days, "days", 365, "Count the commits of the last days, 0 counts the whole history")
+	codeownersCmd.PersistentFlags().Float64Var(&codeownersParameter.blameWeight, "blame-weight", 0.5, "Weight (0-1) of the line authorship, 0 skips the blame")
+}
+
+// codeOwnersOptions converts the code owners configuration.
+func codeOwnersOptions(conf cfg.CodeOwnersConfig) git.CodeOwnersOptions {
+	opts := git.CodeOwnersOptions{
+		Threshold: conf.Threshold,
+		MinOwners: conf.MinOwners,
+		MaxOwners: conf.MaxOwners,
+		Teams:     conf.Teams,
+	}
+	for _, rule := range conf.Pinned {
+		opts.Pinned = append(opts.Pinned, git.CodeOwnersRule{Pattern: rule.Pattern, Owners: rule.Owners})
+	}
+	return opts
+}
+
+// codeownersPath returns the existing CODEOWNERS file, or the location
+// of the forge for a new one.
+func codeownersPath(r *git.Repository) string {
+	if co, err := r.CodeOwners(); err == nil {
+		return filepath.Join(codeownersParameter.repoPath, co.Path)
+	}
+	switch r.Forge().Kind() {
+	case git.ForgeGitHub:
+		return filepath.Join(codeownersParameter.repoPath, ".github", "CODEOWNERS")
+	case git.ForgeGitLab:
+		return filepath.Join(codeownersParameter.repoPath, ".gitlab", "CODEOWNERS")
+	}
+	return filepath.Join(codeownersParameter.repoPath, "CODEOWNERS")
+}
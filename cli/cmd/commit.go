@@ -4,14 +4,14 @@
 package cmd
 
 import (
-	"encoding/json"
+	"docwiz/internal/commit"
+	"docwiz/internal/emoji"
+	docerr "docwiz/internal/error"
 	"fmt"
 	"os"
 	"os/exec"
-	"path/filepath"
 	"strings"
 
-	"github.com/caarlos0/log"
 	"github.com/spf13/cobra"
 )
 
@@ -29,33 +29,37 @@ type commitCmdParameter struct {
 	// exec specifies whether to execute the "git commit" command directly.
 	// If set to true, the command is run using "git commit -m <message>".
 	exec bool
+
+	// file is a message file rewritten in place, as passed by git to
+	// the prepare-commit-msg hook.
+	file string
 }
 
 var (
-	emojiMap        map[string]string
 	commitParameter commitCmdParameter
 	commitCmd       = &cobra.Command{
 		Use:   "commit",
 		Short: "Commit changes with an optional emoji prefix.",
 		Long: `The 'commit' command allows you to make a git commit while optionally 
-enhancing the commit message with relevant emojis based on predefined mappings.`,
-		Example: `  docwiz commit -m "fix: corrected database query"
+enhancing the commit message with relevant emojis: the gitmoji of its conventional
+type, or the emojis of its keywords for the other messages.
+Without -m, an interactive composer walks through the type, scope, subject, body,
+breaking changes, issues and co-authors of the message before committing it.`,
+		Example: `  docwiz commit
+  docwiz commit -m "fix: corrected database query"
   docwiz commit -m "feat: added new API endpoint" -e
-  docwiz commit -m "docs: updated README" -p`,
-		Run: func(cmd *cobra.Command, args []string) {
-			execPath, err := os.Executable()
-			if err != nil {
-				log.WithError(err).Fatal("")
-			}
-			index := filepath.Join(execPath, "../template/COMMIT/index.json")
-			data, err := os.ReadFile(index)
-			if err != nil {
-				log.WithError(err).Fatalf("reading %s", index)
+  docwiz commit -m "docs: updated README" -p
+  docwiz commit -f .git/COMMIT_EDITMSG`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(commitParameter.file) != 0 {
+				if err := addGitEmojiToFile(commitParameter.file); err != nil {
+					return docerr.IO(err, "rewriting %s", commitParameter.file)
+				}
+				return nil
 			}
 
-			err = json.Unmarshal(data, &emojiMap)
-			if err != nil {
-				log.WithError(err).Fatal("parsing json")
+			if len(commitParameter.message) == 0 {
+				return composeCommit()
 			}
 
 			msg := addGitEmoji(commitParameter.message)
@@ -67,10 +71,10 @@ enhancing the commit message with relevant emojis based on predefined mappings.`
 				cmd.Stdin = os.Stdin
 				cmd.Stderr = os.Stderr
 
-				if err = cmd.Run(); err != nil {
-					log.WithError(err).Fatal("running command")
+				if err := cmd.Run(); err != nil {
+					return docerr.Git(err, "running git commit")
 				}
-				return
+				return nil
 			}
 
 			if commitParameter.pure {
@@ -78,7 +82,7 @@ enhancing the commit message with relevant emojis based on predefined mappings.`
 			} else {
 				fmt.Printf(`git commit -m "%s"`, msg)
 			}
-
+			return nil
 		},
 	}
 )
@@ -88,39 +92,28 @@ func init() {
 	commitCmd.PersistentFlags().StringVarP(&commitParameter.message, "message", "m", "", "Commit message to use")
 	commitCmd.PersistentFlags().BoolVarP(&commitParameter.pure, "pure", "p", false, "Output only the processed commit message")
 	commitCmd.PersistentFlags().BoolVarP(&commitParameter.exec, "exec", "e", false, "Execute the git commit command directly")
+	commitCmd.Flags().StringVarP(&commitParameter.file, "file", "f", "", "Add the emojis to the header of a commit message file")
 }
 
-func addGitEmoji(message string) string {
-	// Record the emojis that have been found
-	emojiSet := make(map[string]struct{})
-	// Split the message into words and convert them to lowercase
-	words := strings.Fields(strings.ToLower(message))
-
-	// Iterate over the words to map keywords to emojis
-outer:
-	for _, word := range words {
-		// Loop through each keyword-emoji pair in the emoji map
-		for key, emoji := range emojiMap {
-			// If the word contains the keyword, add the emoji to the set
-			if strings.Contains(word, key) {
-				emojiSet[emoji] = struct{}{}
-				// If there are more than 2 emojis, stop further processing
-				if len(emojiSet) >= 2 {
-					break outer
-				}
-			}
-		}
+// addGitEmojiToFile prefixes the header of a message file with emojis,
+// unless git wrote the message or the header already has emojis.
+func addGitEmojiToFile(filename string) error {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return err
 	}
-
-	// Convert the emoji set to a slice and concatenate the emojis
-	var emojiList []string
-	for emoji := range emojiSet {
-		emojiList = append(emojiList, emoji)
+	raw := string(data)
+	header, rest, _ := strings.Cut(raw, "\n")
+	if len(strings.TrimSpace(header)) == 0 || strings.HasPrefix(header, "#") ||
+		commit.Generated(header) || commit.StripEmoji(header) != header {
+		return nil
 	}
+	raw = addGitEmoji(header) + "\n" + rest
+	return os.WriteFile(filename, []byte(raw), 0644)
+}
 
-	// Prepend the emojis to the message if any emojis are found
-	if len(emojiList) > 0 {
-		return strings.Join(emojiList, " ") + " " + message
-	}
-	return message
+// addGitEmoji prefixes the message with the emoji of its conventional type or,
+// for the other messages, with up to two emojis of its keywords.
+func addGitEmoji(message string) string {
+	return emoji.Default().Decorate(message, 2)
 }
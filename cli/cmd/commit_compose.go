@@ -0,0 +1,131 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package cmd
+
+import (
+	"docwiz/internal/cfg"
+	"docwiz/internal/commit"
+	docerr "docwiz/internal/error"
+	"docwiz/internal/git"
+	"docwiz/internal/tui"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+
+	"github.com/caarlos0/log"
+	"github.com/charmbracelet/huh"
+)
+
+// composeCommit runs the interactive composer and commits the staged changes
+// with the message, or prints the message with --pure.
+func composeCommit() error {
+	r, err := git.New(".")
+	if err != nil {
+		return docerr.Git(err, "fail to read git repository")
+	}
+	staged, err := r.StagedPaths()
+	if err != nil {
+		return docerr.Git(err, "fail to read the index")
+	}
+	if len(staged) == 0 && !commitParameter.pure {
+		return docerr.User(nil, "no changes added to commit, stage them with git add")
+	}
+
+	conf, err := cfg.LoadDocWizConfig(cfg.DocWizConfigFile)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		log.WithError(err).Warn("using the default commit rules")
+	}
+
+	types := conf.Commit.Types
+	if types == nil {
+		types = commit.DefaultTypes
+	}
+	scopes := conf.Commit.Scopes
+	if len(scopes) == 0 {
+		history, err := r.Scopes(200)
+		if err != nil {
+			log.WithError(err).Warn("fail to read the scopes of the history")
+		}
+		scopes = suggestScopes(staged, history)
+	}
+
+	var coAuthors []string
+	people, err := r.People(identityOptions(conf.Identity))
+	if err != nil {
+		log.WithError(err).Warn("fail to read the contributors")
+	}
+	_, email := r.User()
+	for _, p := range people {
+		if len(p.Email) != 0 && !strings.EqualFold(p.Email, email) {
+			coAuthors = append(coAuthors, fmt.Sprintf("%s <%s>", p.Name, p.Email))
+		}
+	}
+
+	m := tui.NewCommitModel(tui.CommitModelConfigure{
+		Types:            types,
+		Scopes:           scopes,
+		Restricted:       len(conf.Commit.Scopes) != 0,
+		CoAuthors:        coAuthors,
+		MaxSubjectLength: conf.Commit.MaxSubjectLength,
+		Decorate:         addGitEmoji,
+	})
+	if err = m.Run(); err != nil {
+		if errors.Is(err, huh.ErrUserAborted) {
+			return docerr.Canceled("commit canceled")
+		}
+		return docerr.Internal(err, "running commit model")
+	}
+	if !m.Confirmed() {
+		return docerr.Canceled("commit canceled")
+	}
+
+	msg := m.Value()
+	if commitParameter.pure {
+		fmt.Println(msg)
+		return nil
+	}
+
+	// git runs the hooks and signs the commit, go-git is the fallback
+	if _, err = exec.LookPath("git"); err == nil {
+		cmd := exec.Command("git", "commit", "-F", "-")
+		cmd.Stdin = strings.NewReader(msg)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err = cmd.Run(); err != nil {
+			return docerr.Git(err, "running git commit")
+		}
+		return nil
+	}
+	hash, err := r.Commit(msg)
+	if err != nil {
+		return docerr.Git(err, "fail to commit")
+	}
+	log.WithField("commit", hash[:7]).Info("committed")
+	return nil
+}
+
+// suggestScopes returns the directories of the staged files, e.g. "git" for
+// "internal/git/tag.go", followed by the scopes of the history.
+func suggestScopes(staged, history []string) []string {
+	var scopes []string
+	seen := make(map[string]struct{})
+	add := func(scope string) {
+		if _, ok := seen[scope]; ok || len(scope) == 0 || scope == "." {
+			return
+		}
+		seen[scope] = struct{}{}
+		scopes = append(scopes, scope)
+	}
+	for _, p := range staged {
+		add(path.Base(path.Dir(p)))
+	}
+	for _, scope := range history {
+		add(scope)
+	}
+	return scopes
+}
@@ -0,0 +1,123 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package cmd
+
+import (
+	"docwiz/internal/cfg"
+	"docwiz/internal/commit"
+	docerr "docwiz/internal/error"
+	"docwiz/internal/git"
+	"docwiz/internal/style"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/caarlos0/log"
+	"github.com/spf13/cobra"
+)
+
+// commitLintCmdParameter stores parameters for the "commit lint" command.
+type commitLintCmdParameter struct {
+	// repoPath specifies the path to the Git repository of the range.
+	repoPath string
+
+	// rangeSpec lints the commits of a revision range, e.g. "v1.0.0..HEAD".
+	rangeSpec string
+}
+
+var (
+	commitLintParameter commitLintCmdParameter
+	commitLintCmd       = &cobra.Command{
+		Use:   "lint [file]",
+		Short: "Check commit messages against Conventional Commits",
+		Long: `The 'commit lint' command checks commit messages against the Conventional Commits
+specification and the rules of the commit section of .docwiz.yaml: the allowed types and
+scopes, the subject length and case and the required footers. The message is read from
+the file argument, from stdin, or from the commits of a range. Merge, revert and fixup
+messages written by git are accepted.
+
+The command exits with status 1 when a message breaks a rule.`,
+		Example: `  docwiz commit lint .git/COMMIT_EDITMSG
+  echo "feat: add parser" | docwiz commit lint
+  docwiz commit lint --range origin/main..HEAD`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			conf, err := cfg.LoadDocWizConfig(cfg.DocWizConfigFile)
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				log.WithError(err).Warn("using the default commit rules")
+			}
+			rules := lintRules(conf.Commit)
+
+			var messages []git.RangeCommit
+			switch {
+			case len(commitLintParameter.rangeSpec) != 0:
+				r, err := git.New(commitLintParameter.repoPath)
+				if err != nil {
+					return docerr.Git(err, "fail to read git repository")
+				}
+				messages, err = r.CommitRange(commitLintParameter.rangeSpec)
+				if err != nil {
+					return docerr.Git(err, "fail to list the commits of %s", commitLintParameter.rangeSpec)
+				}
+			case len(args) == 1 && args[0] != "-":
+				data, err := os.ReadFile(args[0])
+				if err != nil {
+					return docerr.IO(err, "reading %s", args[0])
+				}
+				messages = append(messages, git.RangeCommit{Message: string(data)})
+			default:
+				data, err := io.ReadAll(cmd.InOrStdin())
+				if err != nil {
+					return docerr.IO(err, "reading stdin")
+				}
+				messages = append(messages, git.RangeCommit{Message: string(data)})
+			}
+
+			invalid := 0
+			stderr := cmd.ErrOrStderr()
+			for _, m := range messages {
+				violations := commit.Lint(m.Message, rules)
+				if len(violations) == 0 {
+					continue
+				}
+				invalid++
+				header, _, _ := strings.Cut(commit.Clean(m.Message), "\n")
+				if len(m.Hash) != 0 {
+					header = m.Hash[:7] + " " + header
+				}
+				fmt.Fprintf(stderr, "✖ %s\n", style.Bold(header))
+				for _, v := range violations {
+					fmt.Fprintf(stderr, "  - %s\n", v)
+				}
+			}
+
+			if invalid != 0 {
+				return docerr.Check("%d of %d commit messages break the rules, see https://www.conventionalcommits.org", invalid, len(messages))
+			}
+			log.Infof("%d commit messages checked", len(messages))
+			return nil
+		},
+	}
+)
+
+func init() {
+	commitCmd.AddCommand(commitLintCmd)
+	commitLintCmd.Flags().StringVarP(&commitLintParameter.repoPath, "repository", "r", ".", "Path to the target Git repository")
+	commitLintCmd.Flags().StringVar(&commitLintParameter.rangeSpec, "range", "", "Lint the commits of a revision range, e.g. v1.0.0..HEAD")
+}
+
+// lintRules converts the commit configuration.
+func lintRules(conf cfg.CommitConfig) commit.Rules {
+	return commit.Rules{
+		Types:            conf.Types,
+		Scopes:           conf.Scopes,
+		RequireScope:     conf.RequireScope,
+		MaxSubjectLength: conf.MaxSubjectLength,
+		SubjectCase:      conf.SubjectCase,
+		Footers:          conf.Footers,
+	}
+}
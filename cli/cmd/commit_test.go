@@ -3,30 +3,40 @@
 // license that can be found in the LICENSE file.
 package cmd
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestCommit(t *testing.T) {
-	emojiMap = map[string]string{
-		"fix":  "🔧",
-		"feat": "✨",
-		"docs": "📄",
-	}
-
 	tests := []struct {
 		message  string
 		expected string
 	}{
-		{"fix bug in login", "🔧 fix bug in login"},
+		{"fix bug in login", "🐛 fix bug in login"},
 		{"feat: add dark mode", "✨ feat: add dark mode"},
-		{"docs: update README", "📄 docs: update README"},
-		{"fix and feat together", "🔧 ✨ fix and feat together"},
+		{"docs: update README", "📝 docs: update README"},
+		{"fix!: drop the v1 API", "🐛 💥 fix!: drop the v1 API"},
+		{"fix and feat together", "🐛 ✨ fix and feat together"},
 		{"no matching keyword", "no matching keyword"},
 	}
 
 	for _, tt := range tests {
-		got := addGitEmoji(tt.message)
-		if got != tt.expected {
-			t.Errorf("addGitEmoji(%q) = %q; want %q", tt.message, got, tt.expected)
+		// the matching is deterministic
+		for i := 0; i < 10; i++ {
+			got := addGitEmoji(tt.message)
+			if got != tt.expected {
+				t.Errorf("addGitEmoji(%q) = %q; want %q", tt.message, got, tt.expected)
+				break
+			}
 		}
 	}
 }
+
+func TestSuggestScopes(t *testing.T) {
+	got := suggestScopes([]string{"README.md", "internal/git/tag.go", "internal/git/tag_test.go", "cli/cmd/commit.go"}, []string{"cmd", "parser"})
+	want := []string{"git", "cmd", "parser"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("suggestScopes() = %q; want %q", got, want)
+	}
+}
@@ -4,8 +4,8 @@
 package cmd
 
 import (
+	docerr "docwiz/internal/error"
 	"docwiz/internal/git"
-	"docwiz/internal/io"
 	"docwiz/internal/style"
 
 	"docwiz/internal/os"
@@ -34,7 +34,7 @@ var (
 		Long: `The 'contributing' command allows you to generate a contributing guide (e.g., for open-source projects) based on predefined templates. 
 You can provide information like contribution guidelines, code of conduct, and setup instructions.`,
 		Example: "  docwiz contributing",
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			var (
 				name  string
 				owner string
@@ -57,18 +57,11 @@ You can provide information like contribution guidelines, code of conduct, and s
 			}
 
 			log.Infof("creating %s", contributingParameter.output)
-			output, err := io.NewSafeFile(contributingParameter.output)
+			output, err := createOutput(cmd, contributingParameter.output)
 			if err != nil {
-				log.WithError(err).Fatalf("fail to create file")
+				return err
 			}
-			defer output.Close()
-
-			defer func() {
-				if err := recover(); err != nil {
-					output.Rollback()
-					log.WithError(err.(error)).Fatal("error happen and rollback!")
-				}
-			}()
+			defer discardOutput(output)
 
 			log.WithField("theme", contributorsParameter.theme).
 				WithField("language", contributingParameter.language).
@@ -76,7 +69,7 @@ You can provide information like contribution guidelines, code of conduct, and s
 			tmpl, err := template.Default(tpl)
 
 			if err != nil {
-				log.WithError(err).Fatal("fail to load template")
+				return docerr.Template(err, "fail to load template")
 			}
 
 			log.Info("executing template")
@@ -88,7 +81,7 @@ You can provide information like contribution guidelines, code of conduct, and s
 				"ProjectOwner": owner,
 			})
 			if err != nil {
-				log.WithError(err).Fatal("fail to execute template")
+				return docerr.Template(err, "fail to execute template")
 			}
 
 			if !contributingParameter.disableCopyright {
@@ -96,7 +89,11 @@ You can provide information like contribution guidelines, code of conduct, and s
 			}
 
 			log.Infof("generating %s", style.Bold(changelogParameter.output))
+			if err = closeOutput(output); err != nil {
+				return err
+			}
 			log.Info("thanks for using docwiz!")
+			return nil
 		},
 	}
 )
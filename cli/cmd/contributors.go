@@ -4,9 +4,17 @@
 package cmd
 
 import (
+	"docwiz/internal/cfg"
+	docerr "docwiz/internal/error"
 	"docwiz/internal/git"
-	"docwiz/internal/io"
+	"docwiz/internal/os"
 	"docwiz/internal/style"
+	"docwiz/internal/template"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"slices"
 
 	"github.com/caarlos0/log"
 	"github.com/spf13/cobra"
@@ -18,6 +26,28 @@ type ContributorsCmdParameter struct {
 	// repoPath specifies the path to the Git repository, from which information like tags will be gathered.
 	// The default value is the current directory ("./").
 	repoPath string
+
+	// allContributors is the path to the all-contributors file, it's
+	// merged with the history when it exists.
+	allContributors string
+
+	// update adds the people of the history to the all-contributors file.
+	update bool
+
+	// authorsFile is the people file, it adds the roles, orgs and avatars.
+	authorsFile string
+}
+
+// contributor is a person of the contributors page, the statistics
+// are empty for the people who only appear in .all-contributorsrc.
+type contributor struct {
+	git.ContributorStats
+
+	// Contributions are the all-contributors types, e.g. code, doc or review.
+	Contributions []string
+
+	// Person is the entry of the people file, nil when it isn't listed.
+	Person *cfg.Person
 }
 
 var (
@@ -26,41 +56,94 @@ var (
 		Use:   "contributors",
 		Short: "Generate a contributors list from a Git repository.",
 		Long: `The 'contributors' command scans the Git history of a repository 
-to extract and list all contributors who have committed changes.`,
+to extract and list all contributors who have committed changes, with their
+commits, changed lines, activity and areas. The contributions git can't see
+(docs, design, review, ...) are read from the .all-contributorsrc file, and the roles,
+orgs and avatars of the people file (.authors.yaml).`,
 		Example: `  docwiz contributors -o CONTRIBUTORS.md
-  docwiz contributors -r /path/to/repo -o contributors.txt
+  docwiz contributors -t table -o CONTRIBUTORS.md
+  docwiz contributors -t grid --update-all-contributors
+  docwiz contributors -r /path/to/repo -o contributors.md
   docwiz contributors --disable-copyright`,
-		Run: func(cmd *cobra.Command, args []string) {
-			log.Infof("creating %s", contributorsParameter.output)
-			output, err := io.NewSafeFile(contributorsParameter.output)
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			log.WithField("path", contributorsParameter.repoPath).Info("parsing .git directory")
+			r, err := git.New(contributorsParameter.repoPath)
+			if err != nil {
+				return docerr.Git(err, "fail to read git repository")
+			}
+
+			conf, err := cfg.LoadDocWizConfig(cfg.DocWizConfigFile)
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				log.WithError(err).Warn("using the default identities")
+			}
+
+			stats, err := r.Contributors(git.ContributorsOptions{Identities: identityOptions(conf.Identity)})
 			if err != nil {
-				log.WithError(err).Fatal("fail to create file")
+				return docerr.Git(err, "fail to read the commit history")
 			}
-			defer output.Close()
 
-			defer func() {
-				if err := recover(); err != nil {
-					output.Rollback()
-					log.WithError(err.(error)).Fatal("error happen and rollback!")
+			rc, err := cfg.LoadAllContributors(contributorsParameter.allContributors)
+			if err != nil {
+				if !errors.Is(err, fs.ErrNotExist) {
+					return docerr.IO(err, "fail to read %s", contributorsParameter.allContributors)
 				}
-			}()
+				rc = &cfg.AllContributors{ProjectName: r.Name(), ProjectOwner: r.Owner()}
+			} else {
+				log.WithField("path", contributorsParameter.allContributors).Info("merging all-contributors")
+			}
 
-			log.WithField("path", contributorsParameter.repoPath).Info("parsing .git directory")
-			r, err := git.New(contributorsParameter.repoPath)
+			people := loadPeople(contributorsParameter.authorsFile)
+			contributors := mergeContributors(stats, rc, people)
+			if contributorsParameter.update {
+				updateAllContributors(rc, stats)
+				if dryRun {
+					log.Infof("dry run, %s isn't updated", contributorsParameter.allContributors)
+				} else {
+					log.Infof("updating %s", style.Bold(contributorsParameter.allContributors))
+					if err = rc.Save(contributorsParameter.allContributors); err != nil {
+						return docerr.IO(err, "fail to write %s", contributorsParameter.allContributors)
+					}
+				}
+			}
+
+			contributorsPath := filepath.Join(os.TemplatePath, "CONTRIBUTORS")
+			if contributorsParameter.language != defaultLanguage {
+				contributorsPath = filepath.Join(contributorsPath, contributorsParameter.language)
+			}
+			tpl := filepath.Join(contributorsPath, fmt.Sprintf("%s.tpl", contributorsParameter.theme))
+
+			log.WithField("target", tpl).Info("loading template")
+			tmpl, err := template.Default(tpl)
+			if err != nil {
+				return docerr.Template(err, "fail to load template")
+			}
+
+			log.Infof("creating %s", contributorsParameter.output)
+			output, err := createOutput(cmd, contributorsParameter.output)
 			if err != nil {
-				log.WithError(err).Fatal("fail to read git repository")
+				return err
 			}
+			defer discardOutput(output)
 
 			log.Infof("generating %s", style.Bold(contributorsParameter.output))
-			err = r.GenerateContributors(output)
+			err = tmpl.Execute(output, map[string]any{
+				"ProjectName":   r.Name(),
+				"ProjectOwner":  r.Owner(),
+				"RepositoryURL": r.URL(),
+				"Contributors":  contributors,
+			})
 			if err != nil {
-				log.WithError(err).Fatal("fail to generate contributors")
+				return docerr.Template(err, "fail to generate contributors")
 			}
 
 			if !contributorsParameter.disableCopyright {
 				output.Write(COPYRIGHT)
 			}
+			if err = closeOutput(output); err != nil {
+				return err
+			}
 			log.Info("thanks for using docwiz!")
+			return nil
 		},
 	}
 )
@@ -69,5 +152,85 @@ func init() {
 	docwizCmd.AddCommand(contributorsCmd)
 	contributorsCmd.PersistentFlags().StringVarP(&contributorsParameter.output, "output", "o", "CONTRIBUTORS.md", "Path to the output contributors file")
 	contributorsCmd.PersistentFlags().StringVarP(&contributorsParameter.repoPath, "repo", "r", ".", "Path to the target Git repository")
+	contributorsCmd.PersistentFlags().StringVarP(&contributorsParameter.theme, "theme", "t", "list", "Layout of the contributors (list, table or grid)")
+	contributorsCmd.PersistentFlags().StringVarP(&contributorsParameter.language, "language", "l", "en_us", "Set the language for contributors (e.g. zh_cn)")
+	contributorsCmd.PersistentFlags().StringVar(&contributorsParameter.allContributors, "all-contributors", cfg.AllContributorsFile, "Path to the all-contributors file")
+	contributorsCmd.PersistentFlags().BoolVar(&contributorsParameter.update, "update-all-contributors", false, "Add the people of the history to the all-contributors file")
+	contributorsCmd.PersistentFlags().StringVar(&contributorsParameter.authorsFile, "authors-file", cfg.AuthorsFile, "Path to the people file")
 	contributorsCmd.PersistentFlags().BoolVarP(&contributorsParameter.disableCopyright, "disable-copyright", "d", false, "Disable copyright information in the contributors")
 }
+
+// identityOptions converts the identity configuration.
+func identityOptions(conf cfg.IdentityConfig) git.IdentityOptions {
+	return git.IdentityOptions{Bots: conf.Bots, Logins: conf.Logins}
+}
+
+// mergeContributors joins the history and the all-contributors file by login
+// (or name), the people git doesn't know come after the committers. The
+// committers listed in the people file get their entry.
+func mergeContributors(stats []git.ContributorStats, rc *cfg.AllContributors, people *cfg.Authors) []contributor {
+	var contributors []contributor
+	matched := make(map[*cfg.AllContributor]struct{})
+	for _, s := range stats {
+		c := contributor{ContributorStats: s, Contributions: []string{"code"}}
+		if ac := rc.Find(s.Login, s.Name); ac != nil {
+			matched[ac] = struct{}{}
+			c.Contributions = ac.Contributions
+			if len(c.URL) == 0 {
+				c.URL = ac.Profile
+			}
+			if len(ac.AvatarURL) != 0 {
+				c.Avatar = ac.AvatarURL
+			}
+		}
+		if p := people.Find(s.Email, s.Login, s.Name); p != nil {
+			c.Person = p
+			if len(p.Profile) != 0 {
+				c.URL = p.Profile
+			}
+			if len(p.Avatar) != 0 {
+				c.Avatar = p.Avatar
+			}
+		}
+		contributors = append(contributors, c)
+	}
+
+	for i := range rc.Contributors {
+		ac := &rc.Contributors[i]
+		if _, ok := matched[ac]; ok {
+			continue
+		}
+		name := ac.Name
+		if len(name) == 0 {
+			name = ac.Login
+		}
+		contributors = append(contributors, contributor{
+			ContributorStats: git.ContributorStats{
+				Identity: git.Identity{Name: name, Login: ac.Login},
+				URL:      ac.Profile,
+				Avatar:   ac.AvatarURL,
+			},
+			Contributions: ac.Contributions,
+		})
+	}
+	return contributors
+}
+
+// updateAllContributors records the code contributions of the committers.
+func updateAllContributors(rc *cfg.AllContributors, stats []git.ContributorStats) {
+	for _, s := range stats {
+		ac := rc.Find(s.Login, s.Name)
+		if ac == nil {
+			rc.Contributors = append(rc.Contributors, cfg.AllContributor{
+				Login:     s.Login,
+				Name:      s.Name,
+				AvatarURL: s.Avatar,
+				Profile:   s.URL,
+			})
+			ac = &rc.Contributors[len(rc.Contributors)-1]
+		}
+		if !slices.Contains(ac.Contributions, "code") {
+			ac.Contributions = append(ac.Contributions, "code")
+		}
+	}
+}
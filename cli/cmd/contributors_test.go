@@ -0,0 +1,67 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package cmd
+
+import (
+	"docwiz/internal/cfg"
+	"docwiz/internal/git"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeContributors(t *testing.T) {
+	stats := []git.ContributorStats{
+		{Identity: git.Identity{Name: "Alice", Email: "alice@example.com", Login: "alice"}, Commits: 12, URL: "https://github.com/alice"},
+		{Identity: git.Identity{Name: "Bob", Email: "bob@example.com"}, Commits: 3},
+	}
+	rc := &cfg.AllContributors{Contributors: []cfg.AllContributor{
+		{Login: "Alice", AvatarURL: "https://example.com/alice.png", Contributions: []string{"code", "review"}},
+		{Login: "carol", Profile: "https://github.com/carol", Contributions: []string{"design"}},
+	}}
+	people := &cfg.Authors{Contributors: []cfg.Person{{Name: "Bob", Emails: []string{"bob@example.com"}, Role: "Translator", Profile: "https://bob.example.com"}}}
+
+	contributors := mergeContributors(stats, rc, people)
+	assert.Len(t, contributors, 3)
+
+	alice := contributors[0]
+	assert.Equal(t, []string{"code", "review"}, alice.Contributions)
+	assert.Equal(t, "https://github.com/alice", alice.URL)
+	assert.Equal(t, "https://example.com/alice.png", alice.Avatar)
+	assert.Nil(t, alice.Person)
+
+	bob := contributors[1]
+	assert.Equal(t, []string{"code"}, bob.Contributions)
+	assert.Equal(t, "https://bob.example.com", bob.URL)
+	assert.Equal(t, "Translator", bob.Person.Role)
+
+	carol := contributors[2]
+	assert.Equal(t, "carol", carol.Name)
+	assert.Zero(t, carol.Commits)
+	assert.Equal(t, "https://github.com/carol", carol.URL)
+	assert.Equal(t, []string{"design"}, carol.Contributions)
+}
+
+func TestUpdateAllContributors(t *testing.T) {
+	stats := []git.ContributorStats{
+		{Identity: git.Identity{Name: "Alice", Login: "alice"}},
+		{Identity: git.Identity{Name: "Bob"}, Avatar: "https://example.com/bob.png", URL: "https://bob.example.com"},
+		{Identity: git.Identity{Name: "Carol", Login: "carol"}},
+	}
+	rc := &cfg.AllContributors{Contributors: []cfg.AllContributor{
+		{Login: "alice", Contributions: []string{"doc"}},
+		{Login: "carol", Contributions: []string{"code", "review"}},
+	}}
+
+	updateAllContributors(rc, stats)
+	assert.Len(t, rc.Contributors, 3)
+	assert.Equal(t, []string{"doc", "code"}, rc.Contributors[0].Contributions)
+	assert.Equal(t, []string{"code", "review"}, rc.Contributors[1].Contributions)
+	assert.Equal(t, cfg.AllContributor{
+		Name:          "Bob",
+		AvatarURL:     "https://example.com/bob.png",
+		Profile:       "https://bob.example.com",
+		Contributions: []string{"code"},
+	}, rc.Contributors[2])
+}
@@ -5,6 +5,7 @@ package cmd
 
 import (
 	"bytes"
+	docerr "docwiz/internal/error"
 	"fmt"
 	"io"
 	"os"
@@ -50,17 +51,17 @@ It supports inserting content from a file or directly from a string.`,
 		Example: `  docwiz copyright -p "*.go" -c "Copyright 2025 The DocWiz Authors."
   docwiz copyright -p "src/*.js" -f LICENSE_HEADER.txt
   docwiz copyright -p "*.md" --tail --repeat -c "© 2025 Open Source Project"`,
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			log.Infof("globing %s", copyrightParameter.pattern)
 			files, err := filepath.Glob(copyrightParameter.pattern)
 			if err != nil {
-				log.WithError(err).Fatalf("fail to parse glob pattern")
+				return docerr.User(err, "fail to parse glob pattern")
 			}
 
 			if len(copyrightParameter.file) != 0 {
 				data, err := os.ReadFile(copyrightParameter.file)
 				if err != nil {
-					log.WithError(err).Fatal("invalid copyright file")
+					return docerr.User(err, "invalid copyright file")
 				}
 				copyrightParameter.content = string(data)
 			}
@@ -95,6 +96,7 @@ It supports inserting content from a file or directly from a string.`,
 				}
 			}
 			log.Info("thanks for using docwiz!")
+			return nil
 		},
 	}
 )
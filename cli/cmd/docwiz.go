@@ -4,8 +4,21 @@
 package cmd
 
 import (
+	"docwiz/internal/cfg"
+	"docwiz/internal/emoji"
+	docerr "docwiz/internal/error"
+	"docwiz/internal/git"
+	"docwiz/internal/io"
+	"docwiz/internal/tui"
+	"errors"
+	"fmt"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"strings"
 
+	"github.com/caarlos0/log"
+	"github.com/mattn/go-isatty"
 	"github.com/spf13/cobra"
 )
 
@@ -22,14 +35,253 @@ var (
 		Short: "🚀 A tool for generating project documentation and related files",
 		Long: `docwiz is a versatile command-line tool that helps generate various types of project documentation 
 like README, LICENSE, ROADMAP, CONTRIBUTORS, and more. It leverages templates 
-and user inputs to create customized and professional documentation files.`,
+and user inputs to create customized and professional documentation files.
+
+Exit codes:
+  0    success
+  1    internal error or failed check (e.g. commit lint)
+  2    invalid command line, configuration or data file
+  3    missing or failing template
+  4    unreadable Git repository or history
+  5    unreadable or unwritable file
+  130  canceled by the user`,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := io.ParseOverwritePolicy(overwrite); err != nil {
+				return docerr.User(err, "invalid --overwrite")
+			}
+			conf, err := cfg.LoadDocWizConfig(cfg.DocWizConfigFile)
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				log.WithError(err).Warn("loading " + cfg.DocWizConfigFile)
+			}
+			configureGit(conf.Git)
+			configureEmoji(conf.Emoji)
+			return nil
+		},
 	}
 )
 
+var (
+	// verbose prints the stack trace of the errors.
+	verbose bool
+
+	// overwrite is the policy of the outputs which already exist.
+	overwrite string
+
+	// dryRun prints the generated documents instead of writing them.
+	dryRun bool
+)
+
+func init() {
+	docwizCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Print the stack trace of the errors")
+	docwizCmd.PersistentFlags().StringVar(&overwrite, "overwrite", string(io.OverwriteFail), "What to do with an existing output (fail, overwrite, backup, prompt, merge)")
+
+	// the generators only, the other commands have side effects a dry run would skip
+	for _, cmd := range []*cobra.Command{
+		authorsCmd, changelogCmd, conductCmd, codeownersCmd, contributingCmd, contributorsCmd, gitignoreCmd,
+		issueCmd, licenseCmd, pullRequestCmd, readmeCmd, releaseNotesCmd, reportCmd, roadMapCmd, securityCmd,
+	} {
+		cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Print the generated document and its path, write nothing")
+	}
+}
+
+// Execute runs the command line and exits with the code of the error,
+// see the docwiz/internal/error package for the codes.
 func Execute() {
-	err := docwizCmd.Execute()
+	err := execute()
 	if err != nil {
-		os.Exit(1)
+		var e *docerr.Error
+		if !errors.As(err, &e) {
+			// the errors of cobra, e.g. an unknown flag or command
+			err = docerr.User(err, "invalid command line, see docwiz --help")
+		}
+		reportError(err)
+	}
+	os.Exit(docerr.ExitCode(err))
+}
+
+// execute runs the command line, the panics become internal errors.
+func execute() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = docerr.FromPanic(r)
+		}
+	}()
+	return docwizCmd.Execute()
+}
+
+// reportError logs err with its context, and its stack trace with --verbose.
+func reportError(err error) {
+	var e *docerr.Error
+	errors.As(err, &e)
+	entry := log.WithField("kind", e.Kind)
+	for k, v := range e.Fields {
+		entry = entry.WithField(k, v)
+	}
+	if e.Err != nil {
+		entry = entry.WithError(e.Err)
+	}
+	entry.Error(e.Msg)
+	if verbose {
+		fmt.Fprint(os.Stderr, e.Stack())
+	}
+}
+
+// fileError returns an IO error when the file can't be read, or a user
+// input error when its content is invalid.
+func fileError(err error, format string, args ...any) error {
+	var pathErr *fs.PathError
+	if errors.As(err, &pathErr) {
+		return docerr.IO(err, format, args...)
+	}
+	return docerr.User(err, format, args...)
+}
+
+// writeOptions returns the overwrite options of the output of cmd. The
+// documents which have comments get the region named after the command,
+// e.g. "<!-- docwiz:begin security -->" in SECURITY.md, it's written with
+// the merge policy or when the file already has it. The other files, like
+// LICENSE, can't be merged.
+func writeOptions(cmd *cobra.Command, filename string) io.WriteOptions {
+	policy, _ := io.ParseOverwritePolicy(overwrite)
+	var region io.Region
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".md", ".markdown", ".html", ".htm":
+		region = io.MarkdownRegion(cmd.Name())
+	case ".yml", ".yaml":
+		region = io.HashRegion(cmd.Name())
+	}
+	if filepath.Base(filename) == ".gitignore" {
+		region = io.HashRegion(cmd.Name())
+	}
+	return io.WriteOptions{Policy: policy, Region: region, Confirm: confirmOverwrite}
+}
+
+// confirmOverwrite shows the diff of the output and asks whether to replace it.
+func confirmOverwrite(filename, diff string) (bool, error) {
+	ok, err := tui.ConfirmOverwrite(filename, diff)
+	if err != nil {
+		return false, docerr.Internal(err, "running confirm model")
+	}
+	return ok, nil
+}
+
+// updateOptions returns the options of the documents which always keep
+// the text around their docwiz region, like CODEOWNERS, so fail and merge
+// update the region in place.
+func updateOptions(cmd *cobra.Command, filename string) io.WriteOptions {
+	opts := writeOptions(cmd, filename)
+	// the document already has its own region
+	opts.Region = io.Region{}
+	if opts.Policy == io.OverwriteFail || opts.Policy == io.OverwriteMerge {
+		opts.Policy = io.OverwriteForce
+	}
+	return opts
+}
+
+// createOutput opens the output of cmd, it's written by closeOutput. The dry runs and the "-" output print
+// the document instead.
+func createOutput(cmd *cobra.Command, filename string) (io.Output, error) {
+	opts := writeOptions(cmd, filename)
+	if preview := previewOutput(cmd, filename, opts); preview != nil {
+		return preview, nil
+	}
+	output, err := io.OpenSafeFile(filename, opts)
+	if err != nil {
+		return nil, docerr.IO(err, "fail to create file").WithField("path", filename)
+	}
+	return output, nil
+}
+
+// previewOutput returns the output printing the document to stdout for
+// the dry runs and the "-" output, nil otherwise. The document is printed
+// as opts would write it.
+func previewOutput(cmd *cobra.Command, filename string, opts io.WriteOptions) *io.Preview {
+	if filename == io.Stdout {
+		return io.NewPreview(cmd.OutOrStdout(), filename, opts)
+	}
+	if dryRun {
+		log.WithField("path", filename).Info("dry run, printing the document")
+		return io.NewPreview(cmd.OutOrStdout(), filename, opts)
+	}
+	return nil
+}
+
+// writeOutput writes the whole document of cmd, see createOutput.
+func writeOutput(cmd *cobra.Command, filename string, data []byte, opts io.WriteOptions) error {
+	if preview := previewOutput(cmd, filename, opts); preview != nil {
+		preview.Write(data)
+		return writeError(preview.Close(), filename)
+	}
+	return writeError(io.WriteFile(filename, data, opts), filename)
+}
+
+// closeOutput publishes the output, the commands close it before telling
+// the user they succeeded.
+func closeOutput(output io.Output) error {
+	return writeError(output.Close(), output.Filename())
+}
+
+// discardOutput drops the output unless closeOutput published it, so that
+// a failed or panicking run never leaves a half-written file behind.
+func discardOutput(output io.Output) {
+	if r := recover(); r != nil {
+		output.Rollback()
+		panic(r)
+	}
+	output.Rollback()
+}
+
+// interactive reports whether the standard input is a terminal, the
+// forms can't run without one.
+func interactive() bool {
+	return isatty.IsTerminal(os.Stdin.Fd()) || isatty.IsCygwinTerminal(os.Stdin.Fd())
+}
+
+// writeError converts the error of writing an output, nil stays nil.
+func writeError(err error, filename string) error {
+	var e *docerr.Error
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &e):
+		return err
+	case errors.Is(err, io.ErrDeclined):
+		return docerr.Canceled("kept %s", filename)
+	case errors.Is(err, io.ErrNoRegion):
+		return docerr.User(err, "fail to merge %s", filename)
+	}
+	return docerr.IO(err, "fail to write %s", filename)
+}
+
+// configureGit registers the self-hosted forges and the remote preference of the configuration.
+func configureGit(conf cfg.GitConfig) {
+	if len(conf.Remotes) != 0 {
+		git.RemotePreference = conf.Remotes
+	}
+	for host, name := range conf.Forges {
+		kind, err := git.ParseForgeKind(name)
+		if err != nil {
+			log.WithError(err).WithField("host", host).Warn("ignoring forge")
+			continue
+		}
+		git.ForgeHosts[strings.ToLower(host)] = kind
+	}
+}
+
+// configureEmoji extends the emoji registry with the types and keywords of the configuration.
+func configureEmoji(conf cfg.EmojiConfig) {
+	registry := emoji.Default()
+	for typ, e := range conf.Types {
+		registry.SetType(typ, e)
+	}
+	for _, k := range conf.Keywords {
+		priority := k.Priority
+		if priority == 0 {
+			priority = emoji.PriorityUser
+		}
+		registry.AddKeyword(k.Keyword, k.Emoji, priority)
 	}
 }
 
@@ -50,16 +302,4 @@ type baseParameter struct {
 	//
 	// default: false
 	disableCopyright bool
-
-	verbose bool
 }
-
-// todo
-// func assert(err any, verbose bool) {
-// 	if err != nil {
-// 		if verbose {
-// 			panic(err)
-// 		}
-// 		log.Fata(err)
-// 	}
-// }
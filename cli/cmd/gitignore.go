@@ -4,6 +4,7 @@
 package cmd
 
 import (
+	docerr "docwiz/internal/error"
 	"docwiz/internal/io"
 
 	"docwiz/internal/os"
@@ -44,7 +45,7 @@ You can specify a template directly or pick one interactively.`,
 		Example: `  docwiz gitignore -t Go -o .gitignore
   docwiz gitignore -t Python
   docwiz gitignore`,
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			gitignorePath := filepath.Join(os.TemplatePath, "GITIGNORE")
 			index := map[string]string{}
 			key2File := map[string]string{}
@@ -83,7 +84,7 @@ You can specify a template directly or pick one interactively.`,
 			})
 
 			if err != nil {
-				log.WithError(err).Fatal("fail to read .gitignore template")
+				return docerr.Template(err, "fail to read .gitignore template")
 			}
 
 			var key string
@@ -104,7 +105,7 @@ You can specify a template directly or pick one interactively.`,
 					Candicates:  gitignores,
 				})
 				if err = m.Run(); err != nil {
-					log.WithError(err).Fatal("running select model")
+					return docerr.Internal(err, "running select model")
 				}
 
 				key = m.Value()
@@ -116,13 +117,18 @@ You can specify a template directly or pick one interactively.`,
 				}
 
 				log.Infof("generating %s", style.Bold(gitignoreParameter.output))
-				io.WriteFileFrom(v, gitignoreParameter.output)
+				data, err := io.ReadText(v)
+				if err != nil {
+					return docerr.Template(err, "fail to read .gitignore template")
+				}
+				output := gitignoreParameter.output
+				if err = writeOutput(cmd, output, []byte(data), writeOptions(cmd, output)); err != nil {
+					return err
+				}
 			}
 
-			if err != nil {
-				log.WithError(err).Fatal("")
-			}
 			log.Info("thanks for using docwiz!")
+			return nil
 		},
 	}
 )
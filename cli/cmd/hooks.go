@@ -0,0 +1,136 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package cmd
+
+import (
+	docerr "docwiz/internal/error"
+	"docwiz/internal/git"
+	"docwiz/internal/style"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/caarlos0/log"
+	"github.com/spf13/cobra"
+)
+
+// hooksCmdParameter stores parameters for the "hooks" commands.
+type hooksCmdParameter struct {
+	// repoPath specifies the path to the Git repository.
+	repoPath string
+
+	// force overwrites the hooks docwiz didn't install.
+	force bool
+}
+
+// hookMarker identifies the hooks installed by docwiz.
+const hookMarker = "# installed by docwiz"
+
+// hooks are the scripts installed by "hooks install", they do nothing
+// when docwiz isn't on the PATH so that the clones without it can commit.
+var hooks = map[string]string{
+	"commit-msg": `#!/bin/sh
+` + hookMarker + `: lint the commit message
+command -v docwiz >/dev/null 2>&1 || exit 0
+exec docwiz commit lint "$1"
+`,
+	"prepare-commit-msg": `#!/bin/sh
+` + hookMarker + `: prefix the commit message with emojis
+command -v docwiz >/dev/null 2>&1 || exit 0
+# keep the messages of merges, squashes and amends
+case "$2" in merge|squash|commit) exit 0 ;; esac
+exec docwiz commit -f "$1"
+`,
+}
+
+var (
+	hooksParameter hooksCmdParameter
+	hooksCmd       = &cobra.Command{
+		Use:   "hooks",
+		Short: "Manage the git hooks of docwiz",
+	}
+	hooksInstallCmd = &cobra.Command{
+		Use:   "install",
+		Short: "Install the commit-msg and prepare-commit-msg hooks",
+		Long: `The 'hooks install' command installs the commit-msg hook, which lints the
+commit messages, and the prepare-commit-msg hook, which prefixes them with emojis.
+The hooks are written to core.hooksPath when it's set, or to .git/hooks.
+Existing hooks aren't overwritten without --force.`,
+		Example: `  docwiz hooks install
+  docwiz hooks install -r /path/to/repo --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dir, err := hooksPath()
+			if err != nil {
+				return err
+			}
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				return docerr.IO(err, "creating %s", dir)
+			}
+			for _, name := range []string{"commit-msg", "prepare-commit-msg"} {
+				path := filepath.Join(dir, name)
+				if !hooksParameter.force && !ownHook(path) {
+					log.WithField("path", path).Warn("keeping the existing hook, use --force to overwrite it")
+					continue
+				}
+				if err := os.WriteFile(path, []byte(hooks[name]), 0755); err != nil {
+					return docerr.IO(err, "writing %s", path)
+				}
+				log.Infof("installed %s", style.Bold(path))
+			}
+			return nil
+		},
+	}
+	hooksUninstallCmd = &cobra.Command{
+		Use:   "uninstall",
+		Short: "Remove the hooks installed by docwiz",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dir, err := hooksPath()
+			if err != nil {
+				return err
+			}
+			for _, name := range []string{"commit-msg", "prepare-commit-msg"} {
+				path := filepath.Join(dir, name)
+				if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) || !ownHook(path) {
+					continue
+				}
+				if err := os.Remove(path); err != nil {
+					return docerr.IO(err, "removing %s", path)
+				}
+				log.Infof("removed %s", style.Bold(path))
+			}
+			return nil
+		},
+	}
+)
+
+func init() {
+	docwizCmd.AddCommand(hooksCmd)
+	hooksCmd.AddCommand(hooksInstallCmd, hooksUninstallCmd)
+	hooksCmd.PersistentFlags().StringVarP(&hooksParameter.repoPath, "repository", "r", ".", "Path to the target Git repository")
+	hooksInstallCmd.Flags().BoolVar(&hooksParameter.force, "force", false, "Overwrite the existing hooks")
+}
+
+// hooksPath returns the hooks directory of the repository.
+func hooksPath() (string, error) {
+	r, err := git.New(hooksParameter.repoPath)
+	if err != nil {
+		return "", docerr.Git(err, "fail to read git repository")
+	}
+	dir, err := r.HooksPath()
+	if err != nil {
+		return "", docerr.Git(err, "fail to locate the hooks")
+	}
+	return dir, nil
+}
+
+// ownHook reports whether the hook is missing or was installed by docwiz.
+func ownHook(path string) bool {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return errors.Is(err, fs.ErrNotExist)
+	}
+	return strings.Contains(string(data), hookMarker)
+}
@@ -4,6 +4,7 @@
 package cmd
 
 import (
+	docerr "docwiz/internal/error"
 	"docwiz/internal/git"
 	"docwiz/internal/io"
 
@@ -84,7 +85,7 @@ and other parameters.`,
 
   # Generate an issue template with a custom name and description
   docwiz issue --name "Crash on startup" --description "The app crashes immediately on launch" --format md`,
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			if len(issueParameter.issueName) == 0 {
 				if issueParameter.kind == issueKindBug {
 					issueParameter.issueName = "Bug report"
@@ -100,33 +101,26 @@ and other parameters.`,
 				}
 			}
 
-			var (
-				output *io.SafeFile
-				err    error
-			)
+			if len(issueParameter.output) == 0 {
+				issueParameter.output = "ISSUE.md"
+				if issueParameter.format == issueForamtYAML {
+					issueParameter.output = "ISSUE.yaml"
+				}
+			}
+
+			var output io.Output
 			log.Infof("creating %s", issueParameter.output)
-			output, err = io.NewSafeFile(issueParameter.output)
+			output, err = createOutput(cmd, issueParameter.output)
 			if err != nil {
-				log.WithError(err).Fatalf("fail to create file")
+				return err
 			}
-			defer output.Close()
-
-			defer func() {
-				if err := recover(); err != nil {
-					output.Rollback()
-					log.WithError(err.(error)).Fatal("error happen and rollback!")
-				}
-			}()
+			defer discardOutput(output)
 
 			if issueParameter.format == issueForamtYAML {
-				if len(issueParameter.output) == 0 {
-					issueParameter.output = "ISSUE.yaml"
-				}
-
 				log.WithField("path", issueParameter.repoPath).Info("parsing .git directory")
 				repo, err := git.New(issueParameter.repoPath)
 				if err != nil {
-					log.WithError(err).Fatal("fail to read git repository")
+					return docerr.Git(err, "fail to read git repository")
 				}
 				title := "[Bug]: "
 				labels := []string{"bug", "question"}
@@ -193,21 +187,18 @@ and other parameters.`,
 
 				data, err := yaml.Marshal(&issueTmpl)
 				if err != nil {
-					log.WithError(err).Fatal("marshaling template")
+					return docerr.Template(err, "marshaling template")
 				}
 
 				output.Write(data)
 			} else {
-				if len(issueParameter.output) == 0 {
-					issueParameter.output = "ISSUE.md"
-				}
 				issuePath := filepath.Join(os.TemplatePath, "ISSUE")
 				tpl := filepath.Join(issuePath, fmt.Sprintf("%s.%s.tpl", issueParameter.theme, issueParameter.kind))
 
 				log.WithField("target", tpl).Info("loading template")
 				tmpl, err := template.Default(tpl)
 				if err != nil {
-					log.WithError(err).Fatal("fail to load template")
+					return docerr.Template(err, "fail to load template")
 				}
 
 				log.Info("executing template")
@@ -223,11 +214,15 @@ and other parameters.`,
 				})
 
 				if err != nil {
-					log.WithError(err).Fatal("fail to execute template")
+					return docerr.Template(err, "fail to execute template")
 				}
 			}
 
+			if err = closeOutput(output); err != nil {
+				return err
+			}
 			log.Info("thanks for using docwiz!")
+			return nil
 		},
 	}
 )
@@ -0,0 +1,41 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package cmd
+
+import (
+	"docwiz/internal/io"
+	"docwiz/internal/os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueFrontMatter(t *testing.T) {
+	templatePath := os.TemplatePath
+	os.TemplatePath, _ = filepath.Abs("../../template")
+	t.Cleanup(func() {
+		os.TemplatePath = templatePath
+		overwrite = ""
+	})
+	dir := t.TempDir()
+
+	// GitHub only reads the issue templates which start with their front matter
+	filename := filepath.Join(dir, "ISSUE.md")
+	docwizCmd.SetArgs([]string{"issue", "--kind", "bug", "--format", "md", "-o", filename})
+	assert.NoError(t, docwizCmd.Execute())
+	text, err := io.ReadText(filename)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(text, "---\n"), text)
+	assert.NotContains(t, text, "docwiz:begin")
+
+	filename = filepath.Join(dir, "merged.md")
+	docwizCmd.SetArgs([]string{"issue", "--kind", "bug", "--format", "md", "--overwrite", "merge", "-o", filename})
+	assert.NoError(t, docwizCmd.Execute())
+	text, err = io.ReadText(filename)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(text, "---\n"), text)
+	assert.Contains(t, text, "---\n<!-- docwiz:begin issue -->\n")
+}
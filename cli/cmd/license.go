@@ -4,6 +4,7 @@
 package cmd
 
 import (
+	docerr "docwiz/internal/error"
 	"docwiz/internal/io"
 	"docwiz/internal/style"
 
@@ -47,14 +48,14 @@ and year, or select interactively from available licenses.`,
 		Example: `  docwiz license -l MIT -a "John Doe" -y 2025 -o LICENSE
   docwiz license -l Apache -o LICENSE.txt
   docwiz license`,
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			indexFile := filepath.Join(os.TemplatePath, "LICENSE", "index.json")
 
 			index := map[string]string{}
 
-			err := io.ReadJSON(indexFile, &index)
+			err = io.ReadJSON(indexFile, &index)
 			if err != nil {
-				log.WithError(err).Fatal("reading json")
+				return docerr.IO(err, "reading json")
 			}
 
 			var key string
@@ -62,6 +63,9 @@ and year, or select interactively from available licenses.`,
 			if licenseParameter.license != NoneLicense {
 				key = licenseParameter.license
 			} else {
+				if !interactive() {
+					return docerr.User(nil, "the license is required when stdin isn't a terminal, pass it with -l")
+				}
 				license := []string{NoneLicense}
 
 				for k := range index {
@@ -77,7 +81,7 @@ and year, or select interactively from available licenses.`,
 				})
 
 				if err = m.Run(); err != nil {
-					log.WithError(err).Fatal("running select model")
+					return docerr.Internal(err, "running select model")
 				}
 				key = m.Value()
 			}
@@ -85,24 +89,21 @@ and year, or select interactively from available licenses.`,
 			if v := index[key]; len(v) != 0 {
 				tpl := filepath.Join(os.TemplatePath, fmt.Sprintf("LICENSE/%s.tpl", v))
 
+				var (
+					output io.Output
+					tmpl   *template.Template
+				)
 				log.Infof("creating %s", licenseParameter.output)
-				output, err := io.NewSafeFile(licenseParameter.output)
+				output, err = createOutput(cmd, licenseParameter.output)
 				if err != nil {
-					log.WithError(err).Fatalf("fail to create file")
+					return err
 				}
-				defer output.Close()
-
-				defer func() {
-					if err := recover(); err != nil {
-						output.Rollback()
-						log.WithError(err.(error)).Fatal("error happen and rollback!")
-					}
-				}()
+				defer discardOutput(output)
 
 				log.WithField("target", tpl).Info("loading template")
-				tmpl, err := template.ParseFiles(tpl)
+				tmpl, err = template.ParseFiles(tpl)
 				if err != nil {
-					log.WithError(err).Fatal("fail to load template")
+					return docerr.Template(err, "fail to load template")
 				}
 
 				log.Info("executing template")
@@ -119,12 +120,16 @@ and year, or select interactively from available licenses.`,
 					"Author": licenseParameter.author,
 				})
 				if err != nil {
-					log.WithError(err).Fatal("fail to execute template")
+					return docerr.Template(err, "fail to execute template")
+				}
+				if err = closeOutput(output); err != nil {
+					return err
 				}
 			}
 
 			log.Infof("generating %s", style.Bold(licenseParameter.output))
 			log.Info("thanks for using docwiz!")
+			return nil
 		},
 	}
 )
@@ -4,7 +4,7 @@
 package cmd
 
 import (
-	"docwiz/internal/io"
+	docerr "docwiz/internal/error"
 	"docwiz/internal/style"
 
 	"docwiz/internal/os"
@@ -33,38 +33,35 @@ You can customize the template rendering by specifying the theme. The template w
   
   # You can also use the 'pr' alias for the same functionality
   docwiz pr`,
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			prPath := filepath.Join(os.TemplatePath, "PULL_REQUEST")
 			tpl := filepath.Join(prPath, fmt.Sprintf("%s.tpl", pullRequestParameter.theme))
 
 			log.Infof("creating %s", pullRequestParameter.output)
-			output, err := io.NewSafeFile(pullRequestParameter.output)
+			output, err := createOutput(cmd, pullRequestParameter.output)
 			if err != nil {
-				log.WithError(err).Fatalf("fail to create %s", pullRequestParameter.output)
+				return err
 			}
-			defer output.Close()
-
-			defer func() {
-				if err := recover(); err != nil {
-					output.Rollback()
-					log.WithError(err.(error)).Fatal("error happen and rollback!")
-				}
-			}()
+			defer discardOutput(output)
 
 			log.Info("loading template")
 			tmpl, err := template.Default(tpl)
 			if err != nil {
-				log.WithError(err).Fatal("fail to template")
+				return docerr.Template(err, "fail to template")
 			}
 
 			log.Info("executing template")
 			err = tmpl.Execute(output, nil)
 			if err != nil {
-				log.WithError(err).Fatal("fail to execute template")
+				return docerr.Template(err, "fail to execute template")
 			}
 
 			log.Infof("generating %s", style.Bold(readmeParameter.output))
+			if err = closeOutput(output); err != nil {
+				return err
+			}
 			log.Info("thanks for using docwiz!")
+			return nil
 		},
 	}
 )
@@ -4,12 +4,15 @@
 package cmd
 
 import (
+	"docwiz/internal/badge"
 	"docwiz/internal/cfg"
+	docerr "docwiz/internal/error"
 	"docwiz/internal/io"
 	"docwiz/internal/os"
 	"docwiz/internal/style"
 	"docwiz/internal/template"
 	"docwiz/internal/tui"
+	"docwiz/internal/version"
 	"docwiz/internal/walk"
 	androidwalk "docwiz/internal/walk/android"
 	bashwalk "docwiz/internal/walk/bash"
@@ -59,12 +62,14 @@ import (
 	rustwalk "docwiz/internal/walk/rust"
 	scalawalk "docwiz/internal/walk/scala"
 	soliditywalk "docwiz/internal/walk/solidity"
+	statuswalk "docwiz/internal/walk/status"
 	swiftwalk "docwiz/internal/walk/swift"
 	tswalk "docwiz/internal/walk/ts"
 	vscodewalk "docwiz/internal/walk/vscode"
 	yamlwalk "docwiz/internal/walk/yaml"
 	yarnwalk "docwiz/internal/walk/yarn"
 	zigwalk "docwiz/internal/walk/zig"
+	"errors"
 	"fmt"
 
 	"io/fs"
@@ -85,6 +90,28 @@ type readmeCmdParameter struct {
 	// template defines the programming language template for the README.
 	// It is used to determine which template should be applied when generating the README file.
 	template string
+
+	// maxBadges limits the number of technology badges in the scanned stack, 0 means unlimited.
+	maxBadges int
+
+	// minFiles is the minimum number of files an undeclared technology needs to get a badge.
+	minFiles int
+
+	// minLines is the minimum lines of code an undeclared technology needs to get a badge.
+	minLines int
+
+	// minShare is the minimum share of lines of code an undeclared technology needs to get a badge.
+	minShare float64
+
+	// versionPolicy decides how dependency versions are displayed on the badges
+	// (exact, major.minor, major or hide).
+	versionPolicy string
+
+	// badgeFormat is the markup of the scanned badges (markdown, html, rst or asciidoc).
+	badgeFormat string
+
+	// badgePicture renders the badges as <picture> elements with a dark mode variant.
+	badgePicture bool
 }
 
 var (
@@ -99,9 +126,96 @@ theme, and whether to include copyright information.`,
   docwiz readme -l go -t default -o README.md
   docwiz readme -l python -o docs/README.md
   docwiz readme`,
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			if readmeParameter.scan {
 				ignore, _ := cfg.LoadDocWizIgnore(".docwizignore")
+				conf, err := cfg.LoadDocWizConfig(cfg.DocWizConfigFile)
+				if err != nil && !errors.Is(err, fs.ErrNotExist) {
+					log.WithError(err).Warn("using the default badge settings")
+				}
+
+				ranking := walk.RankOptions{
+					MaxBadges: conf.Badge.Max,
+					MinFiles:  conf.Badge.MinFiles,
+					MinLines:  conf.Badge.MinLines,
+					MinShare:  conf.Badge.MinShare,
+				}
+				if cmd.Flags().Changed("max-badges") {
+					ranking.MaxBadges = readmeParameter.maxBadges
+				}
+				if cmd.Flags().Changed("min-files") {
+					ranking.MinFiles = readmeParameter.minFiles
+				}
+				if cmd.Flags().Changed("min-lines") {
+					ranking.MinLines = readmeParameter.minLines
+				}
+				if cmd.Flags().Changed("min-share") {
+					ranking.MinShare = readmeParameter.minShare
+				}
+
+				versions := walk.VersionOptions{Badges: map[string]version.Policy{}}
+				policy, err := version.ParsePolicy(conf.Badge.Version)
+				if cmd.Flags().Changed("badge-version") {
+					if policy, err = version.ParsePolicy(readmeParameter.versionPolicy); err != nil {
+						return docerr.User(err, "invalid --badge-version")
+					}
+				} else if err != nil {
+					log.WithError(err).Warn("using the exact version policy")
+				}
+				versions.Default = policy
+				for name, v := range conf.Badge.Versions {
+					policy, err := version.ParsePolicy(v)
+					if err != nil {
+						log.WithError(err).WithField("badge", name).Warn("ignoring version policy")
+						continue
+					}
+					versions.Badges[name] = policy
+				}
+
+				if cmd.Flags().Changed("badge-picture") {
+					conf.Badge.Picture = readmeParameter.badgePicture
+				}
+				format, err := badge.ParseFormat(conf.Badge.Format)
+				if cmd.Flags().Changed("badge-format") {
+					if format, err = badge.ParseFormat(readmeParameter.badgeFormat); err != nil {
+						return docerr.User(err, "invalid --badge-format")
+					}
+				} else if err != nil {
+					log.WithError(err).Warn("using the markdown badge format")
+				}
+				if conf.Badge.Picture {
+					// markdown images can't express <picture>
+					if format == badge.FormatMarkdown {
+						format = badge.FormatHTML
+					}
+					badge.ShieldHTMLPicture = true
+				}
+				badge.ShieldPalette = badge.Palette{
+					Color:         conf.Badge.Palette.Color,
+					LogoColor:     conf.Badge.Palette.LogoColor,
+					DarkColor:     conf.Badge.Palette.DarkColor,
+					DarkLogoColor: conf.Badge.Palette.DarkLogoColor,
+				}
+
+				var extra []badge.Badge
+				for _, c := range conf.Badge.Extra {
+					b := &badge.ShieldBadge{
+						ID:        c.ID,
+						Label:     c.Label,
+						Color:     c.Color,
+						Logo:      c.Logo,
+						LogoColor: c.LogoColor,
+						Href:      c.Href,
+					}
+					if len(b.ID) == 0 {
+						b.ID = b.Label
+					}
+					if len(b.Label) == 0 {
+						b.Label = b.ID
+					}
+					b.SetVersion(c.Message)
+					extra = append(extra, b)
+				}
 
 				if len(readmeParameter.output) == 0 {
 					readmeParameter.output = "README.md"
@@ -113,96 +227,48 @@ theme, and whether to include copyright information.`,
 				}
 
 				ctx := &walk.Context{
-					Ignore:   ignore,
-					Output:   readmeParameter.output,
-					Template: tpl,
-					Walkers: []walk.Walker{
-						&androidwalk.Walker{},
-						&bashwalk.Walker{},
-						&cwalk.Walker{},
-						&clojurewalk.Walker{},
-						&cmakewalk.Walker{},
-						&cppwalk.Walker{},
-						&crystalwalk.Walker{},
-						&csharpwalk.Walker{},
-						&csswalk.Walker{},
-						&cudawalk.Walker{},
-						&dartwalk.Walker{},
-						&dockerwalk.Walker{},
-						&elixirwalk.Walker{},
-						&elmwalk.Walker{},
-						&erlangwalk.Walker{},
-						&fortranwalk.Walker{},
-						&gdscriptwalk.Walker{},
-						&gitwalk.Walker{},
-						&gowalk.Walker{},
-						&gradlewalk.Walker{},
-						&graphqlwalk.Walker{},
-						&groovywalk.Walker{},
-						&haskellwalk.Walker{},
-						&htmlwalk.Walker{},
-						&javawalk.Walker{},
-						&jswalk.Walker{},
-						&jspwalk.Walker{},
-						&juliawalk.Walker{},
-						&jupyterwalk.Walker{},
-						&kotlinwalk.Walker{},
-						&latexwalk.Walker{},
-						&luawalk.Walker{},
-						&mdwalk.Walker{},
-						&nimwalk.Walker{},
-						&nixwalk.Walker{},
-						&objectivecwalk.Walker{},
-						&ocamlwalk.Walker{},
-						&perlwalk.Walker{},
-						&phpwalk.Walker{},
-						&powershellwalk.Walker{},
-						&pythonwalk.Walker{},
-						&qtwalk.Walker{},
-						&rwalk.Walker{},
-						&rescriptwalk.Walker{},
-						&rubywalk.Walker{},
-						&rustwalk.Walker{},
-						&scalawalk.Walker{},
-						&soliditywalk.Walker{},
-						&swiftwalk.Walker{},
-						&tswalk.Walker{},
-						&vscodewalk.Walker{},
-						&yamlwalk.Walker{},
-						&yarnwalk.Walker{},
-						&zigwalk.Walker{},
-					},
-				}
-				walk.Walk(".", ctx)
-				tmpl, err := template.New(tpl).LoadStdlib().Parse()
+					Ignore:      ignore,
+					Output:      readmeParameter.output,
+					Template:    tpl,
+					Ranking:     ranking,
+					Versions:    versions,
+					BadgeFormat: format,
+					Extra:       extra,
+					Walkers:     stackWalkers(),
+				}
+				if err = walk.Walk(".", ctx); err != nil {
+					return docerr.IO(err, "fail to scan the project")
+				}
+				for _, warning := range ctx.Warnings {
+					log.WithError(warning).Warn("checking badge")
+				}
+				var (
+					tmpl   *template.DocWizTemplate
+					output io.Output
+				)
+				tmpl, err = template.New(tpl).LoadStdlib().Parse()
 				if err != nil {
-					log.WithError(err).Fatal("loading template")
+					return docerr.Template(err, "loading template")
 				}
 
 				log.Infof("creating %s", readmeParameter.output)
-				output, err := io.NewSafeFile(readmeParameter.output)
+				output, err = createOutput(cmd, readmeParameter.output)
 				if err != nil {
-					log.WithError(err).Fatalf("fail to create file")
+					return err
 				}
-				defer output.Close()
-
-				defer func() {
-					if err := recover(); err != nil {
-						output.Rollback()
-						log.WithError(err.(error)).Fatal("error happen and rollback!")
-					}
-				}()
+				defer discardOutput(output)
 
 				err = tmpl.Execute(output, map[string]any{
 					"ProjectName":        ctx.ProjectName,
 					"ProjectOwner":       ctx.ProjectOwner,
 					"ProjectStack":       ctx.ProjectStack,
+					"ProjectStatistics":  ctx.ProjectStatistics,
 					"ProjectDescription": ctx.ProjectDescription,
 					"Sections":           ctx.Sections,
 				})
 
 				if err != nil {
-					log.WithError(err).Fatal("executing template")
+					return docerr.Template(err, "executing template")
 				} else {
 					log.Info("executing template")
 				}
@@ -210,6 +276,9 @@ theme, and whether to include copyright information.`,
 				if !readmeParameter.disableCopyright {
 					output.Write(COPYRIGHT)
 				}
+				if err = closeOutput(output); err != nil {
+					return err
+				}
 			} else {
 				var chosedTemplate string
 				if len(readmeParameter.template) != 0 {
@@ -239,7 +308,7 @@ theme, and whether to include copyright information.`,
 					})
 
 					if err := m.Run(); err != nil {
-						log.WithError(err).Fatal("")
+						return docerr.Internal(err, "running select model")
 					}
 					chosedTemplate = m.Value()
 				}
@@ -255,7 +324,7 @@ theme, and whether to include copyright information.`,
 
 					err := io.ReadJSON(filepath.Join(templateDir, "index.json"), &index)
 					if err != nil {
-						log.WithError(err).Fatal("reading json")
+						return docerr.IO(err, "reading json")
 					}
 					questions = append(questions, index.Questions...)
 				}
@@ -264,7 +333,7 @@ theme, and whether to include copyright information.`,
 
 				log.Info("🥳 Welcome to use docwiz to create readme.md (use tab to enable default)")
 				if err := m.Run(); err != nil {
-					log.WithError(err).Fatal("running readme model")
+					return docerr.Internal(err, "running readme model")
 				}
 
 				tpl := filepath.Join(templateDir, fmt.Sprintf("%s.tpl", readmeParameter.theme))
@@ -272,27 +341,24 @@ theme, and whether to include copyright information.`,
 					tpl = filepath.Join(templateDir, readmeParameter.language, fmt.Sprintf("%s.tpl", readmeParameter.theme))
 				}
 
-				tmpl, err := template.New(tpl).LoadStdlib().Parse()
+				var (
+					tmpl   *template.DocWizTemplate
+					output io.Output
+				)
+				tmpl, err = template.New(tpl).LoadStdlib().Parse()
 				if err != nil {
-					log.WithError(err).Fatal("loading template")
+					return docerr.Template(err, "loading template")
 				}
 
-				output, err := io.NewSafeFile(readmeParameter.output)
+				output, err = createOutput(cmd, readmeParameter.output)
 				if err != nil {
-					log.WithError(err).Fatalf("creating %s", readmeParameter.output)
+					return err
 				}
-				defer output.Close()
-
-				defer func() {
-					if err := recover(); err != nil {
-						output.Rollback()
-						log.WithError(err.(error)).Fatal("error happen and rollback!")
-					}
-				}()
+				defer discardOutput(output)
 
 				err = tmpl.Execute(output, m.Value())
 				if err != nil {
-					log.WithError(err).Fatal("executing template")
+					return docerr.Template(err, "executing template")
 				} else {
 					log.Info("executing template")
 				}
@@ -300,9 +366,13 @@ theme, and whether to include copyright information.`,
 				if !readmeParameter.disableCopyright {
 					output.Write(COPYRIGHT)
 				}
+				if err = closeOutput(output); err != nil {
+					return err
+				}
 			}
 			log.Infof("generating %s", style.Bold(readmeParameter.output))
 			log.Info("thanks for using docwiz!")
+			return nil
 		},
 	}
 )
@@ -315,4 +385,72 @@ func init() {
 	readmeCmd.PersistentFlags().BoolVarP(&readmeParameter.disableCopyright, "disable-copyright", "d", false, "Disable copyright information in the README")
 	readmeCmd.PersistentFlags().BoolVarP(&readmeParameter.scan, "scan", "s", false, "Automatically scan and generate")
 	readmeCmd.PersistentFlags().StringVarP(&readmeParameter.language, "language", "l", "en_us", "Set the language for contributing file (e.g. zh_cn)")
+	readmeCmd.PersistentFlags().IntVar(&readmeParameter.maxBadges, "max-badges", 0, "Maximum number of technology badges when scanning (0 means unlimited)")
+	readmeCmd.PersistentFlags().IntVar(&readmeParameter.minFiles, "min-files", 2, "Minimum number of files for a technology without a manifest to get a badge")
+	readmeCmd.PersistentFlags().IntVar(&readmeParameter.minLines, "min-lines", 0, "Minimum lines of code for a technology without a manifest to get a badge")
+	readmeCmd.PersistentFlags().StringVar(&readmeParameter.versionPolicy, "badge-version", "exact", "How to display dependency versions on badges (exact, major.minor, major, hide)")
+	readmeCmd.PersistentFlags().StringVar(&readmeParameter.badgeFormat, "badge-format", "markdown", "Markup of the scanned badges (markdown, html, rst, asciidoc)")
+	readmeCmd.PersistentFlags().BoolVar(&readmeParameter.badgePicture, "badge-picture", false, "Render badges as <picture> with a dark mode variant (implies html)")
+	readmeCmd.PersistentFlags().Float64Var(&readmeParameter.minShare, "min-share", 0.05, "Minimum share of lines of code for a technology without a manifest to get a badge")
+}
+
+// stackWalkers returns the walkers detecting the technologies of the project.
+func stackWalkers() []walk.Walker {
+	return []walk.Walker{
+		&androidwalk.Walker{},
+		&bashwalk.Walker{},
+		&cwalk.Walker{},
+		&clojurewalk.Walker{},
+		&cmakewalk.Walker{},
+		&cppwalk.Walker{},
+		&crystalwalk.Walker{},
+		&csharpwalk.Walker{},
+		&csswalk.Walker{},
+		&cudawalk.Walker{},
+		&dartwalk.Walker{},
+		&dockerwalk.Walker{},
+		&elixirwalk.Walker{},
+		&elmwalk.Walker{},
+		&erlangwalk.Walker{},
+		&fortranwalk.Walker{},
+		&gdscriptwalk.Walker{},
+		&gitwalk.Walker{},
+		&gowalk.Walker{},
+		&gradlewalk.Walker{},
+		&graphqlwalk.Walker{},
+		&groovywalk.Walker{},
+		&haskellwalk.Walker{},
+		&htmlwalk.Walker{},
+		&javawalk.Walker{},
+		&jswalk.Walker{},
+		&jspwalk.Walker{},
+		&juliawalk.Walker{},
+		&jupyterwalk.Walker{},
+		&kotlinwalk.Walker{},
+		&latexwalk.Walker{},
+		&luawalk.Walker{},
+		&mdwalk.Walker{},
+		&nimwalk.Walker{},
+		&nixwalk.Walker{},
+		&objectivecwalk.Walker{},
+		&ocamlwalk.Walker{},
+		&perlwalk.Walker{},
+		&phpwalk.Walker{},
+		&powershellwalk.Walker{},
+		&pythonwalk.Walker{},
+		&qtwalk.Walker{},
+		&rwalk.Walker{},
+		&rescriptwalk.Walker{},
+		&rubywalk.Walker{},
+		&rustwalk.Walker{},
+		&scalawalk.Walker{},
+		&soliditywalk.Walker{},
+		&statuswalk.Walker{},
+		&swiftwalk.Walker{},
+		&tswalk.Walker{},
+		&vscodewalk.Walker{},
+		&yamlwalk.Walker{},
+		&yarnwalk.Walker{},
+		&zigwalk.Walker{},
+	}
 }
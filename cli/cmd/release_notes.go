@@ -0,0 +1,164 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package cmd
+
+import (
+	"docwiz/internal/cfg"
+	docerr "docwiz/internal/error"
+	"docwiz/internal/git"
+	"docwiz/internal/os"
+	"docwiz/internal/style"
+	"docwiz/internal/template"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/caarlos0/log"
+	"github.com/spf13/cobra"
+)
+
+type releaseNotesCmdParameter struct {
+	baseParameter
+
+	// repoPath specifies the path to the Git repository.
+	// The default value is the current directory ("./").
+	repoPath string
+
+	// from is the revision of the previous release, the latest tag when empty.
+	from string
+
+	// to is the revision being released.
+	to string
+
+	// next names the unreleased commits after the next version.
+	next bool
+}
+
+var (
+	releaseNotesParameter releaseNotesCmdParameter
+	releaseNotesCmd       = &cobra.Command{
+		Use:   "release-notes",
+		Short: "Generate the release notes of a single version.",
+		Long: `The 'release-notes' command lists the commits between two revisions, by default
+from the latest tag to HEAD, with the highlights, the breaking changes and the contributors.
+The notes are printed to stdout unless an output file is given.`,
+		Example: `  docwiz release-notes
+  docwiz release-notes --from v1.0.0 --to v1.1.0 -o RELEASE_NOTES.md
+  docwiz release-notes -d | gh release create v1.1.0 -F -
+  docwiz release-notes --next -o RELEASE_NOTES.md`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			log.WithField("path", releaseNotesParameter.repoPath).Info("parsing .git directory")
+			r, err := git.New(releaseNotesParameter.repoPath)
+			if err != nil {
+				return docerr.Git(err, "fail to read git repository")
+			}
+
+			conf, err := cfg.LoadDocWizConfig(cfg.DocWizConfigFile)
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				log.WithError(err).Warn("using the default changelog sections")
+			}
+
+			notes, err := r.ReleaseNotes(git.ReleaseNotesOptions{
+				ChangelogOptions: git.ChangelogOptions{
+					Classifier: newClassifier(conf.Changelog),
+					Tags: git.TagOptions{
+						Pattern:         conf.Changelog.TagPattern,
+						SkipPrereleases: conf.Changelog.SkipPrereleases,
+					},
+					FirstParent: conf.Changelog.FirstParent,
+				},
+				From:       releaseNotesParameter.from,
+				To:         releaseNotesParameter.to,
+				Identities: identityOptions(conf.Identity),
+			})
+			if err != nil {
+				return docerr.Git(err, "fail to read the commit history")
+			}
+			if releaseNotesParameter.next && notes.Release.Unreleased {
+				tag, err := nextVersionTag(r, conf.Changelog, "")
+				if err != nil {
+					return docerr.Git(err, "fail to compute the next version")
+				}
+				if len(tag) != 0 {
+					notes.Release = r.NameRelease(notes.Release, tag)
+				}
+			}
+			log.WithField("from", notes.Release.Previous).
+				WithField("to", releaseNotesParameter.to).
+				Info("collecting commits")
+
+			notesPath := filepath.Join(os.TemplatePath, "RELEASE_NOTES")
+			if releaseNotesParameter.language != defaultLanguage {
+				notesPath = filepath.Join(notesPath, releaseNotesParameter.language)
+			}
+			tpl := filepath.Join(notesPath, fmt.Sprintf("%s.tpl", releaseNotesParameter.theme))
+
+			log.WithField("target", tpl).Info("loading template")
+			tmpl, err := template.Default(tpl)
+			if err != nil {
+				return docerr.Template(err, "fail to load template")
+			}
+
+			if releaseNotesParameter.output == "-" {
+				stdout := cmd.OutOrStdout()
+				err = tmpl.Execute(stdout, releaseNotesData(notes))
+				if err != nil {
+					return docerr.Template(err, "fail to execute template")
+				}
+				if !releaseNotesParameter.disableCopyright {
+					stdout.Write(COPYRIGHT)
+				}
+				fmt.Fprintln(stdout)
+				return nil
+			}
+
+			log.Infof("creating %s", releaseNotesParameter.output)
+			output, err := createOutput(cmd, releaseNotesParameter.output)
+			if err != nil {
+				return err
+			}
+			defer discardOutput(output)
+
+			log.Infof("generating %s", style.Bold(releaseNotesParameter.output))
+			err = tmpl.Execute(output, releaseNotesData(notes))
+			if err != nil {
+				return docerr.Template(err, "fail to execute template")
+			}
+
+			if !releaseNotesParameter.disableCopyright {
+				output.Write(COPYRIGHT)
+			}
+			if err = closeOutput(output); err != nil {
+				return err
+			}
+			log.Info("thanks for using docwiz!")
+			return nil
+		},
+	}
+)
+
+func init() {
+	docwizCmd.AddCommand(releaseNotesCmd)
+	releaseNotesCmd.PersistentFlags().StringVarP(&releaseNotesParameter.output, "output", "o", "-", "Path to the output file, - prints the notes to stdout")
+	releaseNotesCmd.PersistentFlags().StringVarP(&releaseNotesParameter.repoPath, "repository", "r", ".", "Path to the target Git repository")
+	releaseNotesCmd.PersistentFlags().StringVar(&releaseNotesParameter.from, "from", "", "Revision of the previous release (default: the latest tag)")
+	releaseNotesCmd.PersistentFlags().StringVar(&releaseNotesParameter.to, "to", "HEAD", "Revision being released")
+	releaseNotesCmd.PersistentFlags().BoolVar(&releaseNotesParameter.next, "next", false, "Name the unreleased commits after the next version")
+	releaseNotesCmd.PersistentFlags().StringVarP(&releaseNotesParameter.theme, "theme", "t", "default", "Theme of the release notes template")
+	releaseNotesCmd.PersistentFlags().StringVarP(&releaseNotesParameter.language, "language", "l", "en_us", "Set the language for release notes (e.g. zh_cn)")
+	releaseNotesCmd.PersistentFlags().BoolVarP(&releaseNotesParameter.disableCopyright, "disable-copyright", "d", false, "Disable copyright information in the release notes")
+}
+
+func releaseNotesData(notes *git.ReleaseNotes) map[string]any {
+	return map[string]any{
+		"ProjectName":   notes.Name,
+		"ProjectOwner":  notes.Owner,
+		"RepositoryURL": notes.URL,
+		"Release":       notes.Release,
+		"Highlights":    notes.Highlights,
+		"Breaking":      notes.Breaking,
+		"Contributors":  notes.Contributors,
+	}
+}
@@ -0,0 +1,330 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package cmd
+
+import (
+	"docwiz/internal/cfg"
+	docerr "docwiz/internal/error"
+	"docwiz/internal/git"
+	"docwiz/internal/io"
+	"docwiz/internal/os"
+	"docwiz/internal/style"
+	"docwiz/internal/template"
+	"docwiz/internal/walk"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/caarlos0/log"
+	"github.com/spf13/cobra"
+)
+
+// reportCmdParameter stores parameters for the "report" command.
+type reportCmdParameter struct {
+	baseParameter
+
+	// repoPath specifies the path to the Git repository.
+	repoPath string
+
+	// days is the period of the churn, 0 counts the whole history.
+	days int
+
+	weeks       int
+	trendDays   int
+	top         int
+	depth       int
+	blameWeight float64
+}
+
+// reportRegion delimits the generated report, the text written
+// around it survives the next runs.
+var reportRegion = io.MarkdownRegion("report")
+
+// reportLanguage is a technology of the project and its size.
+type reportLanguage struct {
+	Name  string
+	Files int
+	Lines int
+
+	// Share is the percentage of the lines of the project.
+	Share float64
+}
+
+// reportDirectory is the bus factor of a directory.
+type reportDirectory struct {
+	Path      string
+	BusFactor int
+
+	// Owners are the people making the bus factor.
+	Owners []string
+}
+
+// reportFile is a file of the hotspots or of the stale files.
+type reportFile struct {
+	git.FileHistory
+	Language string
+
+	// Age is the number of days since the last change.
+	Age int
+}
+
+// reportWeek is a week of the commit cadence.
+type reportWeek struct {
+	git.WeekActivity
+
+	// Bar draws the commits of the week, Percent compares them to the busiest week.
+	Bar     string
+	Percent int
+}
+
+var (
+	reportParameter reportCmdParameter
+	reportCmd       = &cobra.Command{
+		Use:   "report",
+		Short: "Generate a repository health report from the Git history",
+		Long: `The 'report' command computes the health of a repository from its Git history and
+its languages: the bus factor of the directories, the churn hotspots, the stale files, the
+commit cadence by week and the activity trends of the contributors. The report is rendered
+to Markdown or HTML (-t html) inside a docwiz block, the next runs update the block and keep
+the text written around it. The files of .docwizignore are left out.`,
+		Example: `  docwiz report
+  docwiz report -t html -o docs/report.html
+  docwiz report --days 30 --weeks 26 --top 20`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			log.WithField("path", reportParameter.repoPath).Info("parsing .git directory")
+			r, err := git.New(reportParameter.repoPath)
+			if err != nil {
+				return docerr.Git(err, "fail to read git repository")
+			}
+
+			conf, err := cfg.LoadDocWizConfig(cfg.DocWizConfigFile)
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				log.WithError(err).Warn("using the default identities")
+			}
+			ignore, err := cfg.LoadDocWizIgnore(filepath.Join(reportParameter.repoPath, ".docwizignore"))
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				log.WithError(err).Warn("fail to read .docwizignore")
+			}
+
+			now := time.Now()
+			opts := git.ReportOptions{
+				Identities: identityOptions(conf.Identity),
+				Weeks:      reportParameter.weeks,
+				TrendDays:  reportParameter.trendDays,
+				Now:        now,
+				Ignore:     ignore.Git.MatchesPath,
+			}
+			if reportParameter.days > 0 {
+				opts.Since = now.AddDate(0, 0, -reportParameter.days)
+			}
+			log.Info("reading the history")
+			report, err := r.Report(opts)
+			if err != nil {
+				return docerr.Git(err, "fail to read the commit history")
+			}
+
+			log.Info("computing the bus factors")
+			ownership, err := r.Ownership(git.OwnershipOptions{
+				Identities:  opts.Identities,
+				Since:       opts.Since,
+				Depth:       reportParameter.depth,
+				BlameWeight: reportParameter.blameWeight,
+				Ignore:      ignore.Git.MatchesPath,
+			})
+			if err != nil {
+				return docerr.Git(err, "fail to compute the ownership")
+			}
+
+			log.Info("detecting the languages")
+			ctx := &walk.Context{Ignore: ignore, Walkers: stackWalkers()}
+			if err = walk.Walk(reportParameter.repoPath, ctx); err != nil {
+				log.WithError(err).Warn("fail to detect the languages")
+			}
+			languages, fileLanguages := reportLanguages(ctx, reportParameter.repoPath)
+
+			theme := reportParameter.theme
+			if len(reportParameter.output) == 0 {
+				reportParameter.output = "REPORT.md"
+				if theme == "html" {
+					reportParameter.output = "REPORT.html"
+				}
+			}
+
+			reportPath := filepath.Join(os.TemplatePath, "REPORT")
+			if reportParameter.language != defaultLanguage {
+				reportPath = filepath.Join(reportPath, reportParameter.language)
+			}
+			tpl := filepath.Join(reportPath, fmt.Sprintf("%s.tpl", theme))
+
+			log.WithField("target", tpl).Info("loading template")
+			tmpl, err := template.Default(tpl)
+			if err != nil {
+				return docerr.Template(err, "fail to load template")
+			}
+
+			var rendered strings.Builder
+			err = tmpl.Execute(&rendered, map[string]any{
+				"ProjectName":   r.Name(),
+				"RepositoryURL": r.URL(),
+				"Date":          now,
+				"Days":          reportParameter.days,
+				"TrendDays":     opts.TrendDays,
+				"Commits":       report.Commits,
+				"Files":         len(report.Files),
+				"Contributors":  report.Activity,
+				"Languages":     languages,
+				"BusFactors":    busFactors(ownership),
+				"Hotspots":      hotspots(report.Files, fileLanguages, reportParameter.top, now),
+				"Stale":         staleFiles(report.Files, fileLanguages, reportParameter.top, now),
+				"Weeks":         reportWeeks(report.Weeks),
+			})
+			if err != nil {
+				return docerr.Template(err, "fail to execute template")
+			}
+
+			var doc string
+			if data, err := io.ReadText(reportParameter.output); err == nil {
+				log.Infof("updating %s", style.Bold(reportParameter.output))
+				doc = reportRegion.Replace(data, rendered.String(), true)
+			} else if errors.Is(err, fs.ErrNotExist) {
+				log.Infof("generating %s", style.Bold(reportParameter.output))
+				doc = reportRegion.Wrap(rendered.String())
+				if !reportParameter.disableCopyright && theme != "html" {
+					doc += string(COPYRIGHT)
+				}
+			} else {
+				return docerr.IO(err, "reading %s", reportParameter.output)
+			}
+			if err = writeOutput(cmd, reportParameter.output, []byte(doc), updateOptions(cmd, reportParameter.output)); err != nil {
+				return err
+			}
+			log.Info("thanks for using docwiz!")
+			return nil
+		},
+	}
+)
+
+func init() {
+	docwizCmd.AddCommand(reportCmd)
+	reportCmd.PersistentFlags().StringVarP(&reportParameter.output, "output", "o", "", "Path to the output report (default REPORT.md or REPORT.html)")
+	reportCmd.PersistentFlags().StringVarP(&reportParameter.theme, "theme", "t", "markdown", "Format of the report (markdown or html)")
+	reportCmd.PersistentFlags().StringVarP(&reportParameter.language, "language", "l", "en_us", "Set the language for the report (e.g. zh_cn)")
+	reportCmd.PersistentFlags().BoolVarP(&reportParameter.disableCopyright, "disable-copyright", "d", false, "Disable copyright information in the report")
+	reportCmd.PersistentFlags().StringVarP(&reportParameter.repoPath, "repo", "r", ".", "Path to the target Git repository")
+	reportCmd.PersistentFlags().IntVar(&reportParameter.days, "days", 90, "Period of the churn and the bus factors in days, 0 counts the whole history")
+	reportCmd.PersistentFlags().IntVar(&reportParameter.weeks, "weeks", 12, "Number of weeks of the commit cadence")
+	reportCmd.PersistentFlags().IntVar(&reportParameter.trendDays, "trend-days", 90, "Length of the periods compared by the activity trends")
+	reportCmd.PersistentFlags().IntVar(&reportParameter.top, "top", 10, "Number of hotspots and stale files")
+	reportCmd.PersistentFlags().IntVar(&reportParameter.depth, "depth", 1, "Depth of the directories of the bus factors")
+	reportCmd.PersistentFlags().Float64Var(&reportParameter.blameWeight, "blame-weight", 0, "Weight (0-1) of the line authorship in the bus factors, 0 skips the blame")
+}
+
+// reportLanguages returns the languages of the project, the biggest first,
+// and the language of each file of the repository.
+func reportLanguages(ctx *walk.Context, repoPath string) ([]reportLanguage, map[string]string) {
+	root, _ := filepath.Abs(repoPath)
+	observed := ctx.ObservedFiles()
+
+	var languages []reportLanguage
+	total := 0
+	for name, files := range observed {
+		e := ctx.Evidence(name)
+		languages = append(languages, reportLanguage{Name: name, Files: len(files), Lines: e.Lines})
+		total += e.Lines
+	}
+	sort.Slice(languages, func(i, j int) bool {
+		if languages[i].Lines != languages[j].Lines {
+			return languages[i].Lines > languages[j].Lines
+		}
+		return languages[i].Name < languages[j].Name
+	})
+
+	// a file seen by several walkers gets the most common language
+	fileLanguages := make(map[string]string)
+	for i := range languages {
+		languages[i].Share = float64(languages[i].Lines) * 100 / float64(max(total, 1))
+		for _, path := range observed[languages[i].Name] {
+			rel, err := filepath.Rel(root, path)
+			if err != nil {
+				continue
+			}
+			rel = filepath.ToSlash(rel)
+			if current, ok := fileLanguages[rel]; !ok || len(observed[current]) < len(observed[languages[i].Name]) {
+				fileLanguages[rel] = languages[i].Name
+			}
+		}
+	}
+	return languages, fileLanguages
+}
+
+// busFactors returns the directories, the riskiest first.
+func busFactors(ownership []git.DirectoryOwnership) []reportDirectory {
+	var dirs []reportDirectory
+	for _, d := range ownership {
+		dir := reportDirectory{Path: "/" + d.Path, BusFactor: d.BusFactor()}
+		for _, s := range d.Shares[:dir.BusFactor] {
+			dir.Owners = append(dir.Owners, s.Name)
+		}
+		dirs = append(dirs, dir)
+	}
+	sort.SliceStable(dirs, func(i, j int) bool {
+		return dirs[i].BusFactor < dirs[j].BusFactor
+	})
+	return dirs
+}
+
+// hotspots returns the most churned files of the period.
+func hotspots(files []git.FileHistory, languages map[string]string, top int, now time.Time) []reportFile {
+	var hot []reportFile
+	for _, f := range files {
+		if len(hot) == top || f.Commits == 0 {
+			break
+		}
+		hot = append(hot, newReportFile(f, languages, now))
+	}
+	return hot
+}
+
+// staleFiles returns the files changed the longest time ago.
+func staleFiles(files []git.FileHistory, languages map[string]string, top int, now time.Time) []reportFile {
+	var stale []reportFile
+	for _, f := range files {
+		if !f.Modified.IsZero() {
+			stale = append(stale, newReportFile(f, languages, now))
+		}
+	}
+	sort.SliceStable(stale, func(i, j int) bool {
+		return stale[i].Modified.Before(stale[j].Modified)
+	})
+	if len(stale) > top {
+		stale = stale[:top]
+	}
+	return stale
+}
+
+func newReportFile(f git.FileHistory, languages map[string]string, now time.Time) reportFile {
+	return reportFile{FileHistory: f, Language: languages[f.Path], Age: int(now.Sub(f.Modified).Hours() / 24)}
+}
+
+// reportWeeks draws the commit cadence.
+func reportWeeks(weeks []git.WeekActivity) []reportWeek {
+	busiest := 0
+	for _, w := range weeks {
+		busiest = max(busiest, w.Commits)
+	}
+	var drawn []reportWeek
+	for _, w := range weeks {
+		percent := w.Commits * 100 / max(busiest, 1)
+		drawn = append(drawn, reportWeek{
+			WeekActivity: w,
+			Bar:          strings.Repeat("█", (percent+4)/5),
+			Percent:      percent,
+		})
+	}
+	return drawn
+}
@@ -4,15 +4,20 @@
 package cmd
 
 import (
-	"docwiz/internal/io"
+	"docwiz/internal/cfg"
+	docerr "docwiz/internal/error"
+	"docwiz/internal/git"
 
 	"docwiz/internal/os"
 	"docwiz/internal/style"
 	"docwiz/internal/template"
+	"errors"
 	"fmt"
+	"io/fs"
 
 	"path/filepath"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/caarlos0/log"
 	"github.com/spf13/cobra"
 )
@@ -32,7 +37,7 @@ var (
 		Long: `The 'roadmap' command allows you to generate a roadmap (e.g., for a product or project) 
 based on predefined templates and provide information like versioning, kind, theme, etc.`,
 		Example: `  docwiz roadmap -k quarter -t default -o ROADMAP.md -d version=1.0.0`,
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			roadMapPath := filepath.Join(os.TemplatePath, "ROADMAP")
 
 			if roadMapParameter.language != defaultLanguage {
@@ -41,18 +46,11 @@ based on predefined templates and provide information like versioning, kind, the
 			tpl := filepath.Join(roadMapPath, fmt.Sprintf("%s.%s.tpl", roadMapParameter.kind, roadMapParameter.theme))
 
 			log.Infof("creating %s", roadMapParameter.output)
-			output, err := io.NewSafeFile(roadMapParameter.output)
+			output, err := createOutput(cmd, roadMapParameter.output)
 			if err != nil {
-				log.WithError(err).Fatalf("fail to create file")
+				return err
 			}
-			defer output.Close()
-
-			defer func() {
-				if err := recover(); err != nil {
-					output.Rollback()
-					log.WithError(err.(error)).Fatal("error happen and rollback!")
-				}
-			}()
+			defer discardOutput(output)
 
 			log.WithField("kind", roadMapParameter.kind).
 				WithField("theme", roadMapParameter.theme).
@@ -60,10 +58,20 @@ based on predefined templates and provide information like versioning, kind, the
 				WithField("target", tpl).Info("loading template")
 			tmpl, err := template.Default(tpl)
 			if err != nil {
-				log.WithError(err).Fatal("fail to load template")
+				return docerr.Template(err, "fail to load template")
 			}
 
 			version := roadMapParameter.data["version"]
+			if len(version) == 0 {
+				// default to the next version of the current repository
+				if r, err := git.New("."); err == nil {
+					conf, err := cfg.LoadDocWizConfig(cfg.DocWizConfigFile)
+					if err != nil && !errors.Is(err, fs.ErrNotExist) {
+						log.WithError(err).Warn("using the default changelog settings")
+					}
+					version, _ = nextVersionTag(r, conf.Changelog, "")
+				}
+			}
 			log.Info("executing template")
 			log.IncreasePadding()
 			log.WithField("version", version).Info("parameters")
@@ -72,19 +80,28 @@ based on predefined templates and provide information like versioning, kind, the
 			}
 			log.DecreasePadding()
 
-			err = tmpl.Execute(output, map[string]any{
-				"Version": version,
-			})
+			data := map[string]any{}
+			if v, err := semver.NewVersion(version); err == nil {
+				// the templates increment the version with versionIncMinor, ...
+				data["Version"] = *v
+			} else if len(version) != 0 {
+				log.WithError(err).WithField("version", version).Warn("ignoring the version, it isn't a semantic version")
+			}
+			err = tmpl.Execute(output, data)
 
 			if err != nil {
-				log.WithError(err).Fatal("fail to load template")
+				return docerr.Template(err, "fail to load template")
 			}
 
 			if !roadMapParameter.disableCopyright {
 				output.Write(COPYRIGHT)
 			}
 			log.Infof("generating %s", style.Bold(roadMapParameter.output))
+			if err = closeOutput(output); err != nil {
+				return err
+			}
 			log.Info("thanks for using docwiz!")
+			return nil
 		},
 	}
 )
@@ -4,8 +4,9 @@
 package cmd
 
 import (
+	"docwiz/internal/cfg"
+	docerr "docwiz/internal/error"
 	"docwiz/internal/git"
-	"docwiz/internal/io"
 	"docwiz/internal/os"
 	"docwiz/internal/template"
 	"fmt"
@@ -23,6 +24,9 @@ type securityCmdParameter struct {
 	// The default value is the current directory ("./").
 	repoPath string
 	email    string
+
+	// authorsFile is the people file, its maintainers are the security contacts.
+	authorsFile string
 }
 
 var (
@@ -32,9 +36,10 @@ var (
 		Short: "Generate a security guide for your project",
 		Long: `The 'security' command helps you generate a security guide for your project, 
 	providing templates for common security best practices such as handling vulnerabilities, 
-	data privacy, and secure coding guidelines.`,
+	data privacy, and secure coding guidelines. The active maintainers of the people file
+	(.authors.yaml) are listed as the security contacts, the first email is the default one.`,
 		Example: "  docwiz security",
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			var (
 				name  string
 				owner string
@@ -49,6 +54,9 @@ var (
 				log.Warnf("fail to read git repository, err: %s", err.Error())
 			}
 
+			people := contacts(loadPeople(securityParameter.authorsFile))
+			email := contactEmail(securityParameter.email, people)
+
 			securityPath := filepath.Join(os.TemplatePath, "SECURITY")
 			if securityParameter.language != defaultLanguage {
 				securityPath = filepath.Join(securityPath, securityParameter.language)
@@ -56,46 +64,45 @@ var (
 			tpl := filepath.Join(securityPath, fmt.Sprintf("%s.tpl", securityParameter.theme))
 
 			log.Infof("creating %s", securityParameter.output)
-			output, err := io.NewSafeFile(securityParameter.output)
+			output, err := createOutput(cmd, securityParameter.output)
 			if err != nil {
-				log.WithError(err).Fatal("fail to create file")
+				return err
 			}
-			defer output.Close()
-
-			defer func() {
-				if err := recover(); err != nil {
-					output.Rollback()
-					log.WithError(err.(error)).Fatal("error happen and rollback!")
-				}
-			}()
+			defer discardOutput(output)
 
 			log.WithField("target", tpl).Info("loading template")
 			tmpl, err := template.Default(tpl)
 
 			if err != nil {
-				log.WithError(err).Fatal("fail to load template")
+				return docerr.Template(err, "fail to load template")
 			}
 
 			log.Info("executing template")
 			log.IncreasePadding()
 			log.WithField("ProjectName", name).
 				WithField("ProjectOwner", owner).
-				WithField("Email", securityParameter.email).Info("parameters")
+				WithField("Email", email).
+				WithField("Contacts", len(people)).Info("parameters")
 			log.DecreasePadding()
 			err = tmpl.Execute(output, map[string]any{
 				"ProjectName":  name,
 				"ProjectOwner": owner,
-				"Email":        securityParameter.email,
+				"Email":        email,
+				"Contacts":     people,
 			})
 			if err != nil {
-				log.WithError(err).Fatal("fail to execute template")
+				return docerr.Template(err, "fail to execute template")
 			}
 
 			if !securityParameter.disableCopyright {
 				output.Write(COPYRIGHT)
 			}
 
+			if err = closeOutput(output); err != nil {
+				return err
+			}
 			log.Info("thanks for using docwiz!")
+			return nil
 		},
 	}
 )
@@ -107,5 +114,6 @@ func init() {
 	securityCmd.PersistentFlags().BoolVarP(&securityParameter.disableCopyright, "disable-copyright", "d", false, "Disable copyright information in the security")
 	securityCmd.PersistentFlags().StringVarP(&securityParameter.repoPath, "repo", "r", ".", "Path to the target Git repository")
 	securityCmd.PersistentFlags().StringVarP(&securityParameter.email, "email", "e", "", "Email to contact and report issues")
+	securityCmd.PersistentFlags().StringVar(&securityParameter.authorsFile, "authors-file", cfg.AuthorsFile, "Path to the people file listing the security contacts")
 	securityCmd.PersistentFlags().StringVarP(&securityParameter.language, "language", "l", "en_us", "Set the language for contributing file (e.g. zh_cn)")
 }
@@ -4,6 +4,7 @@
 package cmd
 
 import (
+	docerr "docwiz/internal/error"
 	"docwiz/internal/os"
 	"docwiz/internal/tui"
 	"fmt"
@@ -19,17 +20,18 @@ var (
 	tmplParameter tmplCmdParameter
 	tmplCmd       = &cobra.Command{
 		Use: "tmpl",
-		Run: func(cmd *cobra.Command, args []string) {
-			panic("WIP")
+		// the command is a work in progress, it stays out of the help
+		Hidden: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
 			m, err := tui.NewTmpl(filepath.Join(os.TemplatePath, "TMPL", "vue", "index.yaml"))
 			if err != nil {
-				panic(err)
+				return docerr.Template(err, "fail to load template")
 			}
-			err = m.Run()
-			if err != nil {
-				panic(err)
+			if err = m.Run(); err != nil {
+				return docerr.Internal(err, "running tmpl model")
 			}
 			fmt.Println(m.Value())
+			return nil
 		},
 	}
 )
@@ -31,12 +31,13 @@ var (
 		Long: `The 'version' command outputs the version of the CLI tool,
 	including its logo and description. The 'pure' flag can be used to display just the version number.`,
 		Example: "docwiz version\n  docwiz version -p",
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			if versionParameter.pure {
 				fmt.Print(Version)
 			} else {
 				fmt.Printf("%s\n🚀 CLI that generates beautiful git files\nVersion: %s\nHomePage: https://github.com/ansurfen/docwiz", logo, Version)
 			}
+			return nil
 		},
 	}
 )
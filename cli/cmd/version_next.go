@@ -0,0 +1,120 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package cmd
+
+import (
+	"docwiz/internal/cfg"
+	"docwiz/internal/commit"
+	docerr "docwiz/internal/error"
+	"docwiz/internal/git"
+	"docwiz/internal/style"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/caarlos0/log"
+	"github.com/spf13/cobra"
+)
+
+// versionNextCmdParameter stores parameters for the "version next" command.
+type versionNextCmdParameter struct {
+	// repoPath specifies the path to the Git repository.
+	repoPath string
+
+	// pre is the prerelease channel, e.g. "rc" or "beta".
+	pre string
+
+	// tagPattern keeps the version tags matching the glob.
+	tagPattern string
+
+	// tag creates the tag of the next version on HEAD.
+	tag bool
+}
+
+var (
+	versionNextParameter versionNextCmdParameter
+	versionNextCmd       = &cobra.Command{
+		Use:     "next",
+		Aliases: []string{"bump"},
+		Short:   "Compute the next version from the commit history",
+		Long: `The 'version next' command inspects the commits made since the latest version tag
+and applies the Conventional Commits rules: breaking changes bump the major version,
+features the minor version and fixes the patch version. Before 1.0.0, breaking changes
+bump the minor version. The tag of the next version is printed to stdout.`,
+		Example: `  docwiz version next
+  docwiz version next --pre rc
+  docwiz version next --tag-pattern "api/v*" --tag`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			r, err := git.New(versionNextParameter.repoPath)
+			if err != nil {
+				return docerr.Git(err, "fail to read git repository")
+			}
+
+			conf, err := cfg.LoadDocWizConfig(cfg.DocWizConfigFile)
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				log.WithError(err).Warn("using the default configuration")
+			}
+			pattern := conf.Changelog.TagPattern
+			if cmd.Flags().Changed("tag-pattern") {
+				pattern = versionNextParameter.tagPattern
+			}
+
+			next, err := r.NextVersion(git.NextVersionOptions{
+				Tags: git.TagOptions{Pattern: pattern},
+				Pre:  versionNextParameter.pre,
+			})
+			if err != nil {
+				return docerr.Git(err, "fail to compute the next version")
+			}
+
+			current := "none"
+			if next.Current != nil {
+				current = next.Current.Name
+			}
+			log.WithField("current", current).
+				WithField("commits", next.Commits).
+				WithField("bump", next.Bump).
+				Info("analyzing commits")
+
+			if next.Bump == commit.BumpNone {
+				log.Warn("no commit calls for a release")
+				fmt.Fprintln(cmd.OutOrStdout(), current)
+				return nil
+			}
+
+			if versionNextParameter.tag {
+				if err := r.CreateTag(next.Tag); err != nil {
+					return docerr.Git(err, "fail to create tag")
+				}
+				log.Infof("tagged HEAD as %s", style.Bold(next.Tag))
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), next.Tag)
+			return nil
+		},
+	}
+)
+
+func init() {
+	versionCmd.AddCommand(versionNextCmd)
+	versionNextCmd.Flags().StringVarP(&versionNextParameter.repoPath, "repository", "r", ".", "Path to the target Git repository")
+	versionNextCmd.Flags().StringVar(&versionNextParameter.pre, "pre", "", "Prerelease channel of the next version (e.g. alpha, beta, rc)")
+	versionNextCmd.Flags().StringVar(&versionNextParameter.tagPattern, "tag-pattern", "", "Only use the tags matching the glob as versions (e.g. api/v*)")
+	versionNextCmd.Flags().BoolVar(&versionNextParameter.tag, "tag", false, "Create the tag of the next version on HEAD")
+}
+
+// nextVersionTag returns the tag of the next version of the repository,
+// or the empty string when no commit calls for a release.
+func nextVersionTag(r *git.Repository, conf cfg.ChangelogConfig, pre string) (string, error) {
+	next, err := r.NextVersion(git.NextVersionOptions{
+		Tags: git.TagOptions{Pattern: conf.TagPattern},
+		Pre:  pre,
+	})
+	if err != nil {
+		return "", err
+	}
+	if next.Bump == commit.BumpNone {
+		return "", nil
+	}
+	return next.Tag, nil
+}
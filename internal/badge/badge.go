@@ -3,6 +3,11 @@
 // license that can be found in the LICENSE file.
 package badge
 
+import (
+	"fmt"
+	"strings"
+)
+
 type Badge interface {
 	Name() string
 	SetVersion(v string)
@@ -18,3 +23,37 @@ type SortableBadge struct {
 	Badge
 	Tag string
 }
+
+// Format is the markup a badge is rendered with.
+type Format string
+
+const (
+	FormatMarkdown Format = "markdown"
+	FormatHTML     Format = "html"
+	FormatRST      Format = "rst"
+	FormatAsciiDoc Format = "asciidoc"
+)
+
+// ParseFormat converts s into a Format, the empty string is FormatMarkdown.
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(strings.TrimSpace(s))); f {
+	case "":
+		return FormatMarkdown, nil
+	case FormatMarkdown, FormatHTML, FormatRST, FormatAsciiDoc:
+		return f, nil
+	}
+	return FormatMarkdown, fmt.Errorf("invalid badge format: %s", s)
+}
+
+// Render renders b with the markup of the format.
+func Render(b Badge, f Format) string {
+	switch f {
+	case FormatHTML:
+		return b.HTML()
+	case FormatRST:
+		return b.RSt()
+	case FormatAsciiDoc:
+		return b.AsciiDoc()
+	}
+	return b.Markdown()
+}
@@ -0,0 +1,12 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package badge
+
+//go:generate go run ./gen
+
+// Catalog returns the shield badges declared by this package.
+// Run go generate after adding a badge to shield.go.
+func Catalog() []*ShieldBadge {
+	return catalog
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package badge
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const shieldsURL = "https://img.shields.io/"
+
+// DynamicBadge is a badge whose image is rendered by a service from live data
+// (build status, coverage, latest release, ...) instead of a static label.
+type DynamicBadge struct {
+	ID    string
+	Image string
+	Href  string
+}
+
+// NewShieldsBadge creates a DynamicBadge for a shields.io endpoint,
+// e.g. "github/v/release/owner/repo". The badge style defaults to
+// ShieldStyleDefault so that it matches the static badges.
+func NewShieldsBadge(id, endpoint string, query url.Values, href string) *DynamicBadge {
+	if query == nil {
+		query = url.Values{}
+	}
+	if len(query.Get("style")) == 0 {
+		query.Set("style", ShieldStyleDefault)
+	}
+	return &DynamicBadge{
+		ID:    id,
+		Image: shieldsURL + strings.TrimPrefix(endpoint, "/") + "?" + query.Encode(),
+		Href:  href,
+	}
+}
+
+func (b *DynamicBadge) Name() string {
+	return b.ID
+}
+
+// SetVersion is a no-op, the version is computed by the badge service.
+func (*DynamicBadge) SetVersion(string) {}
+
+func (b *DynamicBadge) URL() string {
+	return b.Image
+}
+
+func (b *DynamicBadge) Markdown() string {
+	icon := fmt.Sprintf("![%s](%s)", b.ID, b.Image)
+	if len(b.Href) != 0 {
+		return fmt.Sprintf("[%s](%s)", icon, b.Href)
+	}
+	return icon
+}
+
+func (b *DynamicBadge) RSt() string {
+	if len(b.Href) != 0 {
+		return fmt.Sprintf(`.. image:: %s
+   :alt: %s
+   :target: %s
+`, b.Image, b.ID, b.Href)
+	}
+	return fmt.Sprintf(`.. image:: %s
+   :alt: %s
+`, b.Image, b.ID)
+}
+
+func (b *DynamicBadge) AsciiDoc() string {
+	if len(b.Href) != 0 {
+		return fmt.Sprintf("image:%s[%s,link=%s]", b.Image, b.ID, b.Href)
+	}
+	return fmt.Sprintf("image:%s[%s]", b.Image, b.ID)
+}
+
+func (b *DynamicBadge) HTML() string {
+	icon := fmt.Sprintf(`<img alt="%s" src="%s">`, b.ID, b.Image)
+	if len(b.Href) != 0 {
+		return fmt.Sprintf(`<a href="%s">
+   %s
+</a>
+`, b.Href, icon)
+	}
+	return icon
+}
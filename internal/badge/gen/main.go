@@ -0,0 +1,77 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// gen writes catalog_gen.go, the list of the shield badges declared in shield.go.
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+)
+
+func main() {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "shield.go", nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var names []string
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			for i, name := range vs.Names {
+				if i < len(vs.Values) && isShieldBadge(vs.Values[i]) {
+					names = append(names, name.Name)
+				}
+			}
+		}
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	buf.WriteString(`// Code generated by "go run ./gen"; DO NOT EDIT.
+
+package badge
+
+// catalog lists every shield badge declared in shield.go.
+var catalog = []*ShieldBadge{
+`)
+	for _, name := range names {
+		buf.WriteString("\t" + name + ",\n")
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("catalog_gen.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// isShieldBadge matches &ShieldBadge{...}.
+func isShieldBadge(expr ast.Expr) bool {
+	unary, ok := expr.(*ast.UnaryExpr)
+	if !ok || unary.Op != token.AND {
+		return false
+	}
+	lit, ok := unary.X.(*ast.CompositeLit)
+	if !ok {
+		return false
+	}
+	ident, ok := lit.Type.(*ast.Ident)
+	return ok && ident.Name == "ShieldBadge"
+}
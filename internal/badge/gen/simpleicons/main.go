@@ -0,0 +1,93 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// simpleicons writes simpleicons.txt, the slug index of a Simple Icons
+// release, from the slugs.md file published with the npm package. -in
+// reads a slugs.md downloaded beforehand, e.g. on a machine offline.
+//
+//	go run ./gen/simpleicons -version 13.21.0
+//	go run ./gen/simpleicons -version 13.21.0 -in slugs.md
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+)
+
+func main() {
+	version := flag.String("version", "", "Simple Icons release, e.g. 13.21.0")
+	input := flag.String("in", "", "Path of a downloaded slugs.md, it's fetched from jsDelivr by default")
+	output := flag.String("o", "simpleicons.txt", "Path of the index")
+	flag.Parse()
+	if len(*version) == 0 {
+		log.Fatal("missing -version")
+	}
+
+	url := fmt.Sprintf("https://cdn.jsdelivr.net/npm/simple-icons@%s/slugs.md", *version)
+	var body io.Reader
+	if len(*input) != 0 {
+		f, err := os.Open(*input)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		body, url = f, *input
+	} else {
+		resp, err := http.Get(url)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("GET %s: %s", url, resp.Status)
+		}
+		body = resp.Body
+	}
+
+	// the icons are the rows of a table: | `Title` | `slug` |
+	type icon struct{ slug, title string }
+	var icons []icon
+	scanner := bufio.NewScanner(body)
+	for scanner.Scan() {
+		cells := strings.Split(strings.Trim(scanner.Text(), "| "), "|")
+		if len(cells) != 2 {
+			continue
+		}
+		title := strings.Trim(strings.TrimSpace(cells[0]), "`")
+		slug := strings.Trim(strings.TrimSpace(cells[1]), "`")
+		if len(slug) == 0 || strings.ContainsAny(slug, " :-") {
+			// the header and the separator of the table
+			continue
+		}
+		icons = append(icons, icon{slug, title})
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	if len(icons) == 0 {
+		log.Fatalf("no icon found in %s", url)
+	}
+	sort.Slice(icons, func(i, j int) bool { return icons[i].slug < icons[j].slug })
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# Simple Icons slug index used to validate shields.io logos.\n")
+	fmt.Fprintf(&buf, "# version: simple-icons %s\n", *version)
+	fmt.Fprintf(&buf, "# format: <slug>\\t<title>, sorted by slug\n")
+	fmt.Fprintf(&buf, "# Code generated by \"go run ./gen/simpleicons\"; DO NOT EDIT.\n")
+	for _, i := range icons {
+		fmt.Fprintf(&buf, "%s\t%s\n", i.slug, i.title)
+	}
+	if err := os.WriteFile(*output, buf.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("%d icons written to %s", len(icons), *output)
+}
@@ -0,0 +1,162 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package badge
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+//go:generate go run ./gen/simpleicons -version 13.21.0
+
+// simpleIcons is the index of the Simple Icons logos shields.io knows about,
+// go generate downloads it again, bump the version of the directive above
+// to follow the releases of shields.io. Until then it only lists the logos
+// of the badges, a new logo must be added to it or the index regenerated.
+//
+//go:embed simpleicons.txt
+var simpleIcons string
+
+type logoIndex struct {
+	version string
+	slugs   []string
+	// keys maps the slugs and the normalized titles (shields.io also
+	// accepts "node.js" for nodedotjs) to the slug.
+	keys map[string]string
+}
+
+var (
+	logosOnce sync.Once
+	logos     logoIndex
+)
+
+func loadLogos() *logoIndex {
+	logosOnce.Do(func() {
+		logos.keys = make(map[string]string)
+		scanner := bufio.NewScanner(strings.NewReader(simpleIcons))
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if len(line) == 0 {
+				continue
+			}
+			if strings.HasPrefix(line, "#") {
+				if v, ok := strings.CutPrefix(line, "# version:"); ok {
+					logos.version = strings.TrimSpace(v)
+				}
+				continue
+			}
+			slug, title, _ := strings.Cut(line, "\t")
+			logos.slugs = append(logos.slugs, slug)
+			logos.keys[slug] = slug
+			if len(title) != 0 {
+				logos.keys[normalizeLogo(title)] = slug
+			}
+		}
+	})
+	return &logos
+}
+
+// normalizeLogo mirrors the lookup of shields.io: case insensitive,
+// spaces replaced by dashes.
+func normalizeLogo(name string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), " ", "-")
+}
+
+// LogoIndexVersion returns the Simple Icons release the embedded index was built from.
+func LogoIndexVersion() string {
+	return loadLogos().version
+}
+
+// LookupLogo returns the Simple Icons slug of name, which may be a slug or a title.
+func LookupLogo(name string) (string, bool) {
+	slug, ok := loadLogos().keys[normalizeLogo(name)]
+	return slug, ok
+}
+
+// LogoError reports a logo shields.io won't render as expected.
+type LogoError struct {
+	// Logo is the value of the badge.
+	Logo string
+
+	// Suggestion is the closest valid slug, it may be empty.
+	Suggestion string
+
+	// Unknown is false when the logo is only spelled differently from
+	// its slug (e.g. "Arduino" instead of "arduino").
+	Unknown bool
+}
+
+func (e *LogoError) Error() string {
+	if !e.Unknown {
+		return fmt.Sprintf("logo %q isn't spelled like its slug, use %q", e.Logo, e.Suggestion)
+	}
+	if len(e.Suggestion) != 0 {
+		return fmt.Sprintf("unknown logo %q, did you mean %q?", e.Logo, e.Suggestion)
+	}
+	return fmt.Sprintf("unknown logo %q", e.Logo)
+}
+
+// ValidateLogo checks name against the Simple Icons index. Slugs and the
+// lower case titles (e.g. "node.js") are accepted as is.
+func ValidateLogo(name string) error {
+	index := loadLogos()
+	if _, ok := index.keys[name]; ok {
+		return nil
+	}
+	if slug, ok := LookupLogo(name); ok {
+		return &LogoError{Logo: name, Suggestion: slug}
+	}
+	return &LogoError{Logo: name, Suggestion: SuggestLogo(name), Unknown: true}
+}
+
+// SuggestLogo returns the slug closest to name, or the empty string
+// when nothing is close enough to be a plausible typo.
+func SuggestLogo(name string) string {
+	name = normalizeLogo(name)
+	best, bestDist := "", -1
+	for key, slug := range loadLogos().keys {
+		d := levenshtein(name, key)
+		if bestDist < 0 || d < bestDist || (d == bestDist && slug < best) {
+			best, bestDist = slug, d
+		}
+	}
+	if bestDist < 0 || bestDist > max(2, len(name)/3) {
+		return ""
+	}
+	return best
+}
+
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// Validate checks the logo of the badge, badges without logo are valid.
+func (s *ShieldBadge) Validate() error {
+	if len(s.Logo) == 0 {
+		return nil
+	}
+	if err := ValidateLogo(s.Logo); err != nil {
+		return fmt.Errorf("badge %s: %w", s.ID, err)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package badge
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalogLogos(t *testing.T) {
+	assert.NotEmpty(t, LogoIndexVersion())
+	logos := walkerLogos(t)
+	for _, b := range Catalog() {
+		logos[b.ID] = b.Logo
+	}
+	assert.NotEmpty(t, logos)
+
+	for badge, logo := range logos {
+		if len(logo) == 0 {
+			continue
+		}
+		// run go generate when a new logo is missing from an outdated index
+		if err := ValidateLogo(logo); err != nil {
+			t.Errorf("badge %s: %v", badge, err)
+		}
+	}
+}
+
+// walkerLogos returns the logos of the shield badges declared by the
+// walkers, keyed by their position, e.g. "go/badge.go:12".
+func walkerLogos(t *testing.T) map[string]string {
+	logos := make(map[string]string)
+	fset := token.NewFileSet()
+	files, err := filepath.Glob(filepath.Join("..", "walk", "*", "*.go"))
+	assert.NoError(t, err)
+	for _, filename := range files {
+		if strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filename, nil, 0)
+		assert.NoError(t, err)
+		ast.Inspect(file, func(n ast.Node) bool {
+			lit, ok := n.(*ast.CompositeLit)
+			if !ok {
+				return true
+			}
+			if sel, ok := lit.Type.(*ast.SelectorExpr); !ok || sel.Sel.Name != "ShieldBadge" {
+				return true
+			}
+			for _, elt := range lit.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+				key, ok := kv.Key.(*ast.Ident)
+				value, isLit := kv.Value.(*ast.BasicLit)
+				if ok && isLit && key.Name == "Logo" {
+					pos := fset.Position(lit.Pos())
+					rel, _ := filepath.Rel(filepath.Join("..", "walk"), pos.Filename)
+					logo, _ := strconv.Unquote(value.Value)
+					logos[fmt.Sprintf("%s:%d", filepath.ToSlash(rel), pos.Line)] = logo
+				}
+			}
+			return true
+		})
+	}
+	return logos
+}
+
+func TestValidateLogo(t *testing.T) {
+	assert.NoError(t, ValidateLogo("nodedotjs"))
+	assert.NoError(t, ValidateLogo("node.js"))
+	assert.NoError(t, ValidateLogo("tailwind-css"))
+
+	var logoErr *LogoError
+	err := ValidateLogo("Arduino")
+	assert.True(t, errors.As(err, &logoErr))
+	assert.False(t, logoErr.Unknown)
+	assert.Equal(t, "arduino", logoErr.Suggestion)
+
+	err = ValidateLogo("microsoft sql server")
+	assert.True(t, errors.As(err, &logoErr))
+	assert.Equal(t, "microsoftsqlserver", logoErr.Suggestion)
+
+	err = ValidateLogo("kubernets")
+	assert.True(t, errors.As(err, &logoErr))
+	assert.True(t, logoErr.Unknown)
+	assert.Equal(t, "kubernetes", logoErr.Suggestion)
+
+	assert.Equal(t, "", SuggestLogo("definitely-not-a-logo"))
+}
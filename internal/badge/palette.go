@@ -0,0 +1,38 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package badge
+
+// Palette overrides the colors of every shield badge, e.g. to recolor
+// them to a team's brand. Empty fields keep the badge's own colors.
+type Palette struct {
+	Color         string
+	LogoColor     string
+	DarkColor     string
+	DarkLogoColor string
+}
+
+// ShieldPalette is the palette applied to all the shield badges.
+var ShieldPalette Palette
+
+func (p Palette) light(b *ShieldBadge) (color, logoColor string) {
+	return pick(p.Color, b.Color), pick(p.LogoColor, b.LogoColor)
+}
+
+// dark falls back on the light colors, so a palette only defining
+// Color recolors both variants.
+func (p Palette) dark(b *ShieldBadge) (color, logoColor string) {
+	color = pick(p.DarkColor, p.Color, b.DarkColor, b.Color)
+	logoColor = pick(p.DarkLogoColor, p.LogoColor, b.DarkLogoColor, b.LogoColor)
+	return
+}
+
+// pick returns the first non-empty value.
+func pick(values ...string) string {
+	for _, v := range values {
+		if len(v) != 0 {
+			return v
+		}
+	}
+	return ""
+}
@@ -21,6 +21,10 @@ const (
 
 var ShieldStyleDefault = ShieldStyleForTheBadge
 
+// ShieldHTMLPicture makes ShieldBadge.HTML emit a <picture> element with
+// a dark source for the badges that have a dark variant.
+var ShieldHTMLPicture = false
+
 type ShieldBadge struct {
 	ID        string
 	Label     string
@@ -30,6 +34,12 @@ type ShieldBadge struct {
 	Logo      string
 	LogoColor string
 	Href      string
+
+	// DarkColor and DarkLogoColor are the variants used on dark backgrounds,
+	// typically for badges whose brand color is black. They are optional,
+	// the light colors are used when they're empty.
+	DarkColor     string
+	DarkLogoColor string
 }
 
 func (s *ShieldBadge) Name() string {
@@ -40,17 +50,35 @@ func (s *ShieldBadge) SetVersion(v string) {
 	s.message = v
 }
 
+// URL returns the badge for light backgrounds.
 func (b *ShieldBadge) URL() string {
+	color, logoColor := ShieldPalette.light(b)
+	return b.url(color, logoColor)
+}
+
+// DarkURL returns the badge for dark backgrounds, it equals URL when
+// the badge has no dark variant.
+func (b *ShieldBadge) DarkURL() string {
+	color, logoColor := ShieldPalette.dark(b)
+	return b.url(color, logoColor)
+}
+
+// Themed reports whether the badge looks different on dark backgrounds.
+func (b *ShieldBadge) Themed() bool {
+	return b.URL() != b.DarkURL()
+}
+
+func (b *ShieldBadge) url(color, logoColor string) string {
 	var sb strings.Builder
 
-	sb.WriteString(url.PathEscape(b.Label))
+	sb.WriteString(escapeShield(b.Label))
 	if len(b.message) != 0 {
 		sb.WriteString("-")
-		sb.WriteString(url.PathEscape(b.message))
+		sb.WriteString(escapeShield(b.message))
 	}
-	if len(b.Color) != 0 {
+	if len(color) != 0 {
 		sb.WriteString("-")
-		sb.WriteString(url.PathEscape(b.Color))
+		sb.WriteString(url.PathEscape(color))
 	}
 	sb.WriteString(".svg")
 
@@ -61,13 +89,13 @@ func (b *ShieldBadge) URL() string {
 	}
 
 	if b.Style != "" {
-		params.Set("style", url.QueryEscape(b.Style))
+		params.Set("style", b.Style)
 	}
 	if b.Logo != "" {
-		params.Set("logo", url.QueryEscape(b.Logo))
+		params.Set("logo", b.Logo)
 	}
-	if b.LogoColor != "" {
-		params.Set("logoColor", url.QueryEscape(b.LogoColor))
+	if logoColor != "" {
+		params.Set("logoColor", logoColor)
 	}
 
 	if len(params) > 0 {
@@ -77,6 +105,14 @@ func (b *ShieldBadge) URL() string {
 	return baseURL + sb.String()
 }
 
+// escapeShield escapes a label or message of a static badge, shields.io
+// uses "-" as the separator, so literal dashes and underscores are doubled.
+func escapeShield(s string) string {
+	s = strings.ReplaceAll(s, "-", "--")
+	s = strings.ReplaceAll(s, "_", "__")
+	return url.PathEscape(s)
+}
+
 func (s *ShieldBadge) Markdown() string {
 	icon := fmt.Sprintf("![%s](%s)", s.ID, s.URL())
 	if len(s.Href) != 0 {
@@ -95,8 +131,16 @@ func (s *ShieldBadge) AsciiDoc() string {
 	return fmt.Sprintf("image:%s[%s]", s.URL(), s.ID)
 }
 
+// HTML renders the badge as an <img>, or as a <picture> switching to the
+// dark variant with prefers-color-scheme when ShieldHTMLPicture is set.
 func (s *ShieldBadge) HTML() string {
 	icon := fmt.Sprintf(`<img alt="%s" src="%s">`, s.ID, s.URL())
+	if ShieldHTMLPicture && s.Themed() {
+		icon = fmt.Sprintf(`<picture>
+     <source media="(prefers-color-scheme: dark)" srcset="%s">
+     %s
+   </picture>`, s.DarkURL(), icon)
+	}
 	if len(s.Href) != 0 {
 		return fmt.Sprintf(`<a href="%s">
    %s
@@ -118,13 +162,15 @@ var (
 	}
 
 	ShieldAnsible = &ShieldBadge{
-		ID:        "Ansible",
-		Label:     "Ansible",
-		Color:     "#1A1918",
-		Style:     ShieldStyleForTheBadge,
-		Logo:      "ansible",
-		LogoColor: "white",
-		Href:      "https://www.ansible.com/",
+		ID:            "Ansible",
+		Label:         "Ansible",
+		Color:         "#1A1918",
+		DarkColor:     "#E6EDF3",
+		DarkLogoColor: "black",
+		Style:         ShieldStyleForTheBadge,
+		Logo:          "ansible",
+		LogoColor:     "white",
+		Href:          "https://www.ansible.com/",
 	}
 
 	ShieldArduino = &ShieldBadge{
@@ -132,7 +178,7 @@ var (
 		Label:     "Arduino",
 		Color:     "#00979D",
 		Style:     ShieldStyleForTheBadge,
-		Logo:      "Arduino",
+		Logo:      "arduino",
 		LogoColor: "white",
 		Href:      "https://www.arduino.cc/",
 	}
@@ -211,6 +257,7 @@ var (
 		ID:        "FFmpeg",
 		Label:     "FFmpeg",
 		Color:     "#171717",
+		DarkColor: "#E6EDF3",
 		Style:     ShieldStyleDefault,
 		Logo:      "ffmpeg",
 		LogoColor: "#5cb85c",
@@ -294,7 +341,7 @@ var (
 		Label:     "Amazon DynamoDB",
 		Color:     "#4053D6",
 		Style:     ShieldStyleDefault,
-		Logo:      "Amazon DynamoDB",
+		Logo:      "amazondynamodb",
 		LogoColor: "white",
 		Href:      "https://aws.amazon.com/dynamodb/",
 	}
@@ -344,7 +391,7 @@ var (
 		Label:     "Cockroach Labs",
 		Color:     "#6933FF",
 		Style:     ShieldStyleDefault,
-		Logo:      "Cockroach Labs",
+		Logo:      "cockroachlabs",
 		LogoColor: "white",
 		Href:      "https://www.cockroachlabs.com/",
 	}
@@ -364,7 +411,7 @@ var (
 		Label:     "CrateDB",
 		Color:     "#009DC7",
 		Style:     ShieldStyleDefault,
-		Logo:      "CrateDB",
+		Logo:      "cratedb",
 		LogoColor: "white",
 		Href:      "https://crate.io/",
 	}
@@ -384,7 +431,7 @@ var (
 		Label:     "InfluxDB",
 		Color:     "#22ADF6",
 		Style:     ShieldStyleDefault,
-		Logo:      "InfluxDB",
+		Logo:      "influxdb",
 		LogoColor: "white",
 		Href:      "https://www.influxdata.com/",
 	}
@@ -414,7 +461,7 @@ var (
 		Label:     "Microsoft SQL Server",
 		Color:     "#CC2927",
 		Style:     ShieldStyleDefault,
-		Logo:      "microsoft sql server",
+		Logo:      "microsoftsqlserver",
 		LogoColor: "white",
 		Href:      "https://www.microsoft.com/en-us/sql-server",
 	}
@@ -450,13 +497,15 @@ var (
 	}
 
 	ShieldPlanetScale = &ShieldBadge{
-		ID:        "PlanetScale",
-		Label:     "PlanetScale",
-		Color:     "#000000",
-		Style:     ShieldStyleDefault,
-		Logo:      "planetscale",
-		LogoColor: "white",
-		Href:      "https://planetscale.com/",
+		ID:            "PlanetScale",
+		Label:         "PlanetScale",
+		Color:         "#000000",
+		DarkColor:     "#E6EDF3",
+		DarkLogoColor: "black",
+		Style:         ShieldStyleDefault,
+		Logo:          "planetscale",
+		LogoColor:     "white",
+		Href:          "https://planetscale.com/",
 	}
 
 	ShieldPocketBase = &ShieldBadge{
@@ -464,7 +513,7 @@ var (
 		Label:     "PocketBase",
 		Color:     "#b8dbe4",
 		Style:     ShieldStyleDefault,
-		Logo:      "Pocketbase",
+		Logo:      "pocketbase",
 		LogoColor: "black",
 		Href:      "https://pocketbase.io/",
 	}
@@ -642,13 +691,15 @@ var (
 	}
 
 	ShieldApacheKafka = &ShieldBadge{
-		ID:        "Apache Kafka",
-		Label:     "Apache Kafka",
-		Color:     "000000",
-		Style:     ShieldStyleDefault,
-		Logo:      "apachekafka",
-		LogoColor: "white",
-		Href:      "https://kafka.apache.org/",
+		ID:            "Apache Kafka",
+		Label:         "Apache Kafka",
+		Color:         "000000",
+		DarkColor:     "#E6EDF3",
+		DarkLogoColor: "black",
+		Style:         ShieldStyleDefault,
+		Logo:          "apachekafka",
+		LogoColor:     "white",
+		Href:          "https://kafka.apache.org/",
 	}
 
 	ShieldApacheHadoop = &ShieldBadge{
@@ -742,13 +793,15 @@ var (
 	}
 
 	ShieldBun = &ShieldBadge{
-		ID:        "Bun",
-		Label:     "Bun",
-		Color:     "#000000",
-		Style:     ShieldStyleDefault,
-		Logo:      "bun",
-		LogoColor: "white",
-		Href:      "https://bun.sh/",
+		ID:            "Bun",
+		Label:         "Bun",
+		Color:         "#000000",
+		DarkColor:     "#E6EDF3",
+		DarkLogoColor: "black",
+		Style:         ShieldStyleDefault,
+		Logo:          "bun",
+		LogoColor:     "white",
+		Href:          "https://bun.sh/",
 	}
 
 	ShieldCelery = &ShieldBadge{
@@ -786,25 +839,28 @@ var (
 		Label:     "Code Igniter",
 		Color:     "#EF4223",
 		Style:     ShieldStyleDefault,
-		Logo:      "codeIgniter",
+		Logo:      "codeigniter",
 		LogoColor: "white",
 		Href:      "https://codeigniter.com/",
 	}
 
 	ShieldContextAPI = &ShieldBadge{
-		ID:        "Context-API",
-		Label:     "Context API",
-		Color:     "#000000",
-		Style:     ShieldStyleDefault,
-		Logo:      "react",
-		LogoColor: "white",
-		Href:      "https://reactjs.org/docs/context.html",
+		ID:            "Context-API",
+		Label:         "Context API",
+		Color:         "#000000",
+		DarkColor:     "#E6EDF3",
+		DarkLogoColor: "black",
+		Style:         ShieldStyleDefault,
+		Logo:          "react",
+		LogoColor:     "white",
+		Href:          "https://reactjs.org/docs/context.html",
 	}
 
 	ShieldCUDA = &ShieldBadge{
 		ID:        "nVIDIA",
 		Label:     "CUDA",
 		Color:     "#000000",
+		DarkColor: "#E6EDF3",
 		Style:     ShieldStyleDefault,
 		Logo:      "nvidia",
 		LogoColor: "green",
@@ -822,13 +878,15 @@ var (
 	}
 
 	ShieldDenoJS = &ShieldBadge{
-		ID:        "Deno JS",
-		Label:     "Deno JS",
-		Color:     "000000",
-		Style:     ShieldStyleDefault,
-		Logo:      "deno",
-		LogoColor: "white",
-		Href:      "https://deno.land/",
+		ID:            "Deno JS",
+		Label:         "Deno JS",
+		Color:         "000000",
+		DarkColor:     "#E6EDF3",
+		DarkLogoColor: "black",
+		Style:         ShieldStyleDefault,
+		Logo:          "deno",
+		LogoColor:     "white",
+		Href:          "https://deno.land/",
 	}
 
 	ShieldDirectus = &ShieldBadge{
@@ -952,33 +1010,35 @@ var (
 	}
 
 	ShieldFastify = &ShieldBadge{
-		ID:        "Fastify",
-		Label:     "Fastify",
-		Color:     "#000000",
-		Style:     ShieldStyleDefault,
-		Logo:      "fastify",
-		LogoColor: "white",
-		Href:      "https://www.fastify.io/",
+		ID:            "Fastify",
+		Label:         "Fastify",
+		Color:         "#000000",
+		DarkColor:     "#E6EDF3",
+		DarkLogoColor: "black",
+		Style:         ShieldStyleDefault,
+		Logo:          "fastify",
+		LogoColor:     "white",
+		Href:          "https://www.fastify.io/",
 	}
 
 	ShieldFilament = &ShieldBadge{
-		ID:        "Filament",
-		Label:     "Filament",
-		Color:     "#FFAA00",
-		Style:     ShieldStyleDefault,
-		Logo:      "filament",
-		LogoColor: "#000000",
-		Href:      "https://filamentphp.com/",
+		ID:    "Filament",
+		Label: "Filament",
+		Color: "#FFAA00",
+		Style: ShieldStyleDefault,
+		Href:  "https://filamentphp.com/",
 	}
 
 	ShieldFlask = &ShieldBadge{
-		ID:        "Flask",
-		Label:     "Flask",
-		Color:     "#000000",
-		Style:     ShieldStyleDefault,
-		Logo:      "flask",
-		LogoColor: "white",
-		Href:      "https://flask.palletsprojects.com/",
+		ID:            "Flask",
+		Label:         "Flask",
+		Color:         "#000000",
+		DarkColor:     "#E6EDF3",
+		DarkLogoColor: "black",
+		Style:         ShieldStyleDefault,
+		Logo:          "flask",
+		LogoColor:     "white",
+		Href:          "https://flask.palletsprojects.com/",
 	}
 
 	ShieldFlutter = &ShieldBadge{
@@ -1055,6 +1115,7 @@ var (
 		ID:        "Insomnia",
 		Label:     "Insomnia",
 		Color:     "black",
+		DarkColor: "#E6EDF3",
 		Style:     ShieldStyleDefault,
 		Logo:      "insomnia",
 		LogoColor: "#5849BE",
@@ -1062,23 +1123,27 @@ var (
 	}
 
 	ShieldHandlebars = &ShieldBadge{
-		ID:        "Handlebars",
-		Label:     "Handlebars",
-		Color:     "#000000",
-		Style:     ShieldStyleDefault,
-		Logo:      "Handlebars.js",
-		LogoColor: "white",
-		Href:      "https://handlebarsjs.com/",
+		ID:            "Handlebars",
+		Label:         "Handlebars",
+		Color:         "#000000",
+		DarkColor:     "#E6EDF3",
+		DarkLogoColor: "black",
+		Style:         ShieldStyleDefault,
+		Logo:          "handlebarsdotjs",
+		LogoColor:     "white",
+		Href:          "https://handlebarsjs.com/",
 	}
 
 	ShieldHugo = &ShieldBadge{
-		ID:        "Hugo",
-		Label:     "Hugo",
-		Color:     "black",
-		Style:     ShieldStyleDefault,
-		Logo:      "hugo",
-		LogoColor: "white",
-		Href:      "https://gohugo.io/",
+		ID:            "Hugo",
+		Label:         "Hugo",
+		Color:         "black",
+		DarkColor:     "#E6EDF3",
+		DarkLogoColor: "black",
+		Style:         ShieldStyleDefault,
+		Logo:          "hugo",
+		LogoColor:     "white",
+		Href:          "https://gohugo.io/",
 	}
 
 	ShieldIonic = &ShieldBadge{
@@ -1102,13 +1167,11 @@ var (
 	}
 
 	ShieldJavaFX = &ShieldBadge{
-		ID:        "JavaFX",
-		Label:     "JavaFX",
-		Color:     "#FF0000",
-		Style:     ShieldStyleDefault,
-		Logo:      "javafx",
-		LogoColor: "white",
-		Href:      "https://openjfx.io/",
+		ID:    "JavaFX",
+		Label: "JavaFX",
+		Color: "#FF0000",
+		Style: ShieldStyleDefault,
+		Href:  "https://openjfx.io/",
 	}
 
 	ShieldJinja = &ShieldBadge{
@@ -1142,13 +1205,15 @@ var (
 	}
 
 	ShieldJWT = &ShieldBadge{
-		ID:        "JWT",
-		Label:     "JWT",
-		Color:     "black",
-		Style:     ShieldStyleDefault,
-		Logo:      "JSON web tokens",
-		LogoColor: "white",
-		Href:      "https://jwt.io/",
+		ID:            "JWT",
+		Label:         "JWT",
+		Color:         "black",
+		DarkColor:     "#E6EDF3",
+		DarkLogoColor: "black",
+		Style:         ShieldStyleDefault,
+		Logo:          "jsonwebtokens",
+		LogoColor:     "white",
+		Href:          "https://jwt.io/",
 	}
 
 	ShieldLaravel = &ShieldBadge{
@@ -1242,13 +1307,15 @@ var (
 	}
 
 	ShieldNextJS = &ShieldBadge{
-		ID:        "Next JS",
-		Label:     "Next JS",
-		Color:     "black",
-		Style:     ShieldStyleDefault,
-		Logo:      "next.js",
-		LogoColor: "white",
-		Href:      "https://nextjs.org/",
+		ID:            "Next JS",
+		Label:         "Next JS",
+		Color:         "black",
+		DarkColor:     "#E6EDF3",
+		DarkLogoColor: "black",
+		Style:         ShieldStyleDefault,
+		Logo:          "next.js",
+		LogoColor:     "white",
+		Href:          "https://nextjs.org/",
 	}
 
 	ShieldNodeJS = &ShieldBadge{
@@ -1396,7 +1463,7 @@ var (
 		Label:     "Qt",
 		Color:     "#217346",
 		Style:     ShieldStyleDefault,
-		Logo:      "Qt",
+		Logo:      "qt",
 		LogoColor: "white",
 		Href:      "https://www.qt.io/",
 	}
@@ -1442,13 +1509,15 @@ var (
 	}
 
 	ShieldRadixUI = &ShieldBadge{
-		ID:        "Radix UI",
-		Label:     "Radix UI",
-		Color:     "#161618",
-		Style:     ShieldStyleDefault,
-		Logo:      "radix-ui",
-		LogoColor: "white",
-		Href:      "https://www.radix-ui.com/",
+		ID:            "Radix UI",
+		Label:         "Radix UI",
+		Color:         "#161618",
+		DarkColor:     "#E6EDF3",
+		DarkLogoColor: "black",
+		Style:         ShieldStyleDefault,
+		Logo:          "radix-ui",
+		LogoColor:     "white",
+		Href:          "https://www.radix-ui.com/",
 	}
 
 	ShieldRails = &ShieldBadge{
@@ -1532,13 +1601,15 @@ var (
 	}
 
 	ShieldRemix = &ShieldBadge{
-		ID:        "Remix",
-		Label:     "Remix",
-		Color:     "black",
-		Style:     ShieldStyleDefault,
-		Logo:      "remix",
-		LogoColor: "white",
-		Href:      "https://remix.run/",
+		ID:            "Remix",
+		Label:         "Remix",
+		Color:         "black",
+		DarkColor:     "#E6EDF3",
+		DarkLogoColor: "black",
+		Style:         ShieldStyleDefault,
+		Logo:          "remix",
+		LogoColor:     "white",
+		Href:          "https://remix.run/",
 	}
 
 	ShieldRollupJS = &ShieldBadge{
@@ -1576,7 +1647,7 @@ var (
 		Label:     "SASS",
 		Color:     "hotpink",
 		Style:     ShieldStyleDefault,
-		Logo:      "SASS",
+		Logo:      "sass",
 		LogoColor: "white",
 		Href:      "https://sass-lang.com/",
 	}
@@ -1611,13 +1682,15 @@ var (
 		Href:      "https://www.snowflake.com/",
 	}
 	ShieldSocketIO = &ShieldBadge{
-		ID:        "Socket.io",
-		Label:     "Socket.io",
-		Color:     "black",
-		Style:     ShieldStyleDefault,
-		Logo:      "socket.io",
-		LogoColor: "white",
-		Href:      "https://socket.io/",
+		ID:            "Socket.io",
+		Label:         "Socket.io",
+		Color:         "black",
+		DarkColor:     "#E6EDF3",
+		DarkLogoColor: "black",
+		Style:         ShieldStyleDefault,
+		Logo:          "socket.io",
+		LogoColor:     "white",
+		Href:          "https://socket.io/",
 	}
 
 	ShieldSolidJS = &ShieldBadge{
@@ -1701,13 +1774,15 @@ var (
 	}
 
 	ShieldSymfony = &ShieldBadge{
-		ID:        "Symfony",
-		Label:     "Symfony",
-		Color:     "#000000",
-		Style:     ShieldStyleDefault,
-		Logo:      "symfony",
-		LogoColor: "white",
-		Href:      "https://symfony.com/",
+		ID:            "Symfony",
+		Label:         "Symfony",
+		Color:         "#000000",
+		DarkColor:     "#E6EDF3",
+		DarkLogoColor: "black",
+		Style:         ShieldStyleDefault,
+		Logo:          "symfony",
+		LogoColor:     "white",
+		Href:          "https://symfony.com/",
 	}
 
 	ShieldTailwindCSS = &ShieldBadge{
@@ -1731,13 +1806,15 @@ var (
 	}
 
 	ShieldThreeJS = &ShieldBadge{
-		ID:        "Three.js",
-		Label:     "Three.js",
-		Color:     "black",
-		Style:     ShieldStyleDefault,
-		Logo:      "three.js",
-		LogoColor: "white",
-		Href:      "https://threejs.org/",
+		ID:            "Three.js",
+		Label:         "Three.js",
+		Color:         "black",
+		DarkColor:     "#E6EDF3",
+		DarkLogoColor: "black",
+		Style:         ShieldStyleDefault,
+		Logo:          "three.js",
+		LogoColor:     "white",
+		Href:          "https://threejs.org/",
 	}
 
 	ShieldThymeleaf = &ShieldBadge{
@@ -1755,7 +1832,7 @@ var (
 		Label:     "tRPC",
 		Color:     "#2596BE",
 		Style:     ShieldStyleDefault,
-		Logo:      "tRPC",
+		Logo:      "trpc",
 		LogoColor: "white",
 		Href:      "https://trpc.io/",
 	}
@@ -1765,7 +1842,7 @@ var (
 		Label:     "TypeGraphQL",
 		Color:     "#C04392",
 		Style:     ShieldStyleDefault,
-		Logo:      "type-graphql",
+		Logo:      "typegraphql",
 		LogoColor: "white",
 		Href:      "https://typegraphql.ml/",
 	}
@@ -1855,7 +1932,7 @@ var (
 		Label:     "WordPress",
 		Color:     "#117AC9",
 		Style:     ShieldStyleDefault,
-		Logo:      "WordPress",
+		Logo:      "wordpress",
 		LogoColor: "white",
 		Href:      "https://wordpress.org/",
 	}
@@ -1895,19 +1972,21 @@ var (
 		Label:     "Apache Groovy",
 		Color:     "#4298B8",
 		Style:     ShieldStyleDefault,
-		Logo:      "Apache Groovy",
+		Logo:      "apachegroovy",
 		LogoColor: "white",
 		Href:      "https://groovy-lang.org/",
 	}
 
 	ShieldAssemblyScript = &ShieldBadge{
-		ID:        "AssemblyScript",
-		Label:     "AssemblyScript",
-		Color:     "#000000",
-		Style:     ShieldStyleDefault,
-		Logo:      "assemblyscript",
-		LogoColor: "white",
-		Href:      "https://www.assemblyscript.org/",
+		ID:            "AssemblyScript",
+		Label:         "AssemblyScript",
+		Color:         "#000000",
+		DarkColor:     "#E6EDF3",
+		DarkLogoColor: "black",
+		Style:         ShieldStyleDefault,
+		Logo:          "assemblyscript",
+		LogoColor:     "white",
+		Href:          "https://www.assemblyscript.org/",
 	}
 
 	ShieldC = &ShieldBadge{
@@ -1951,13 +2030,15 @@ var (
 	}
 
 	ShieldCrystal = &ShieldBadge{
-		ID:        "Crystal",
-		Label:     "Crystal",
-		Color:     "#000000",
-		Style:     ShieldStyleDefault,
-		Logo:      "crystal",
-		LogoColor: "white",
-		Href:      "https://crystal-lang.org/",
+		ID:            "Crystal",
+		Label:         "Crystal",
+		Color:         "#000000",
+		DarkColor:     "#E6EDF3",
+		DarkLogoColor: "black",
+		Style:         ShieldStyleDefault,
+		Logo:          "crystal",
+		LogoColor:     "white",
+		Href:          "https://crystal-lang.org/",
 	}
 
 	ShieldCSS3 = &ShieldBadge{
@@ -2141,13 +2222,15 @@ var (
 	}
 
 	ShieldMarkdown = &ShieldBadge{
-		ID:        "Markdown",
-		Label:     "Markdown",
-		Color:     "#000000",
-		Style:     ShieldStyleDefault,
-		Logo:      "markdown",
-		LogoColor: "white",
-		Href:      "https://www.markdownguide.org/",
+		ID:            "Markdown",
+		Label:         "Markdown",
+		Color:         "#000000",
+		DarkColor:     "#E6EDF3",
+		DarkLogoColor: "black",
+		Style:         ShieldStyleDefault,
+		Logo:          "markdown",
+		LogoColor:     "white",
+		Href:          "https://www.markdownguide.org/",
 	}
 
 	ShieldNim = &ShieldBadge{
@@ -2281,13 +2364,15 @@ var (
 	}
 
 	ShieldRust = &ShieldBadge{
-		ID:        "Rust",
-		Label:     "Rust",
-		Color:     "#000000",
-		Style:     ShieldStyleDefault,
-		Logo:      "rust",
-		LogoColor: "white",
-		Href:      "https://www.rust-lang.org/",
+		ID:            "Rust",
+		Label:         "Rust",
+		Color:         "#000000",
+		DarkColor:     "#E6EDF3",
+		DarkLogoColor: "black",
+		Style:         ShieldStyleDefault,
+		Logo:          "rust",
+		LogoColor:     "white",
+		Href:          "https://www.rust-lang.org/",
 	}
 
 	ShieldScala = &ShieldBadge{
@@ -2301,13 +2386,15 @@ var (
 	}
 
 	ShieldBashScript = &ShieldBadge{
-		ID:        "BashScript",
-		Label:     "Bash Script",
-		Color:     "#121011",
-		Style:     ShieldStyleDefault,
-		Logo:      "gnu-bash",
-		LogoColor: "white",
-		Href:      "https://www.gnu.org/software/bash/",
+		ID:            "BashScript",
+		Label:         "Bash Script",
+		Color:         "#121011",
+		DarkColor:     "#E6EDF3",
+		DarkLogoColor: "black",
+		Style:         ShieldStyleDefault,
+		Logo:          "gnu-bash",
+		LogoColor:     "white",
+		Href:          "https://www.gnu.org/software/bash/",
 	}
 
 	ShieldSolidity = &ShieldBadge{
@@ -2671,23 +2758,27 @@ var (
 	}
 
 	ShieldGitHub = &ShieldBadge{
-		ID:        "GitHub",
-		Label:     "GitHub",
-		Color:     "#121011",
-		Style:     ShieldStyleDefault,
-		Logo:      "github",
-		LogoColor: "white",
-		Href:      "https://github.com/",
+		ID:            "GitHub",
+		Label:         "GitHub",
+		Color:         "#121011",
+		DarkColor:     "#E6EDF3",
+		DarkLogoColor: "black",
+		Style:         ShieldStyleDefault,
+		Logo:          "github",
+		LogoColor:     "white",
+		Href:          "https://github.com/",
 	}
 
 	ShieldGitLab = &ShieldBadge{
-		ID:        "GitLab",
-		Label:     "GitLab",
-		Color:     "#181717",
-		Style:     ShieldStyleDefault,
-		Logo:      "gitlab",
-		LogoColor: "white",
-		Href:      "https://gitlab.com/",
+		ID:            "GitLab",
+		Label:         "GitLab",
+		Color:         "#181717",
+		DarkColor:     "#E6EDF3",
+		DarkLogoColor: "black",
+		Style:         ShieldStyleDefault,
+		Logo:          "gitlab",
+		LogoColor:     "white",
+		Href:          "https://gitlab.com/",
 	}
 
 	ShieldGitpod = &ShieldBadge{
@@ -0,0 +1,59 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package badge
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShieldURL(t *testing.T) {
+	b := &ShieldBadge{ID: "Test", Label: "my-lib", Color: "#ffdd54", Style: ShieldStyleFlat, Logo: "go", LogoColor: "white"}
+	b.SetVersion("1.0_rc")
+	assert.Equal(t, "https://img.shields.io/badge/my--lib-1.0__rc-%23ffdd54.svg?logo=go&logoColor=white&style=flat", b.URL())
+	assert.False(t, b.Themed())
+	assert.Equal(t, b.URL(), b.DarkURL())
+}
+
+func TestShieldDarkVariant(t *testing.T) {
+	b := *ShieldRust
+	assert.True(t, b.Themed())
+	assert.Contains(t, b.URL(), "-%23000000.svg")
+	assert.Contains(t, b.DarkURL(), "-%23E6EDF3.svg")
+	assert.Contains(t, b.DarkURL(), "logoColor=black")
+
+	assert.NotContains(t, b.HTML(), "<picture>")
+
+	ShieldHTMLPicture = true
+	defer func() { ShieldHTMLPicture = false }()
+	html := b.HTML()
+	assert.True(t, strings.Contains(html, `<source media="(prefers-color-scheme: dark)" srcset="`+b.DarkURL()+`">`))
+	assert.True(t, strings.Contains(html, `src="`+b.URL()+`"`))
+}
+
+func TestShieldPalette(t *testing.T) {
+	ShieldPalette = Palette{Color: "#123456"}
+	defer func() { ShieldPalette = Palette{} }()
+
+	b := *ShieldRust
+	assert.Contains(t, b.URL(), "-%23123456.svg")
+	// the palette color applies to both variants
+	assert.Contains(t, b.DarkURL(), "-%23123456.svg")
+
+	ShieldPalette.DarkColor = "white"
+	assert.Contains(t, b.DarkURL(), "-white.svg")
+}
+
+func TestRender(t *testing.T) {
+	b := &ShieldBadge{ID: "Go", Label: "Go", Color: "blue"}
+	f, err := ParseFormat("HTML")
+	assert.NoError(t, err)
+	assert.Equal(t, b.HTML(), Render(b, f))
+	assert.Equal(t, b.Markdown(), Render(b, ""))
+
+	_, err = ParseFormat("pdf")
+	assert.Error(t, err)
+}
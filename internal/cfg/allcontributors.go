@@ -0,0 +1,118 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package cfg
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+)
+
+// AllContributorsFile is the default name of the all-contributors specification.
+const AllContributorsFile = ".all-contributorsrc"
+
+// AllContributors is the .all-contributorsrc file of the all-contributors
+// specification (https://allcontributors.org/docs/en/specification).
+// It records the contributions git can't see, like docs, design or review.
+type AllContributors struct {
+	ProjectName  string
+	ProjectOwner string
+	Contributors []AllContributor
+
+	// fields keeps the settings docwiz doesn't use (files, imageSize, ...)
+	// so that saving the file doesn't lose them.
+	fields map[string]json.RawMessage
+}
+
+// AllContributor is a person of .all-contributorsrc.
+type AllContributor struct {
+	Login     string `json:"login"`
+	Name      string `json:"name"`
+	AvatarURL string `json:"avatar_url"`
+	Profile   string `json:"profile"`
+
+	// Contributions are the contribution types, e.g. code, doc, design or review.
+	Contributions []string `json:"contributions"`
+}
+
+// LoadAllContributors reads the all-contributors file.
+func LoadAllContributors(filename string) (*AllContributors, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	rc := &AllContributors{}
+	if err = json.Unmarshal(data, &rc.fields); err != nil {
+		return nil, err
+	}
+	for key, target := range map[string]any{
+		"projectName":  &rc.ProjectName,
+		"projectOwner": &rc.ProjectOwner,
+		"contributors": &rc.Contributors,
+	} {
+		if raw, ok := rc.fields[key]; ok {
+			if err = json.Unmarshal(raw, target); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return rc, nil
+}
+
+// Find returns the contributor with the login or, without login, the name.
+func (rc *AllContributors) Find(login, name string) *AllContributor {
+	for i := range rc.Contributors {
+		c := &rc.Contributors[i]
+		if len(login) != 0 && strings.EqualFold(c.Login, login) || len(login) == 0 && c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// Save writes the file with the layout of the all-contributors CLI.
+func (rc *AllContributors) Save(filename string) error {
+	if rc.fields == nil {
+		rc.fields = map[string]json.RawMessage{
+			"files":               json.RawMessage(`["README.md"]`),
+			"imageSize":           json.RawMessage(`100`),
+			"commit":              json.RawMessage(`false`),
+			"contributorsPerLine": json.RawMessage(`7`),
+		}
+	}
+	if rc.Contributors == nil {
+		rc.Contributors = []AllContributor{}
+	}
+	for key, value := range map[string]any{
+		"projectName":  rc.ProjectName,
+		"projectOwner": rc.ProjectOwner,
+		"contributors": rc.Contributors,
+	} {
+		raw, err := marshalJSON(value, "")
+		if err != nil {
+			return err
+		}
+		rc.fields[key] = raw
+	}
+
+	data, err := marshalJSON(rc.fields, "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, data, 0644)
+}
+
+// marshalJSON encodes v without escaping the HTML of the badge templates.
+func marshalJSON(v any, indent string) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", indent)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,42 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package cfg
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllContributors(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), AllContributorsFile)
+	err := os.WriteFile(filename, []byte(`{
+  "projectName": "widget",
+  "projectOwner": "acme",
+  "badgeTemplate": "<custom>",
+  "contributors": [
+    {"login": "Alice", "name": "Alice", "avatar_url": "a.png", "profile": "https://alice.dev", "contributions": ["doc", "review"]},
+    {"login": "", "name": "Dave", "contributions": ["design"]}
+  ]
+}`), 0644)
+	assert.NoError(t, err)
+
+	rc, err := LoadAllContributors(filename)
+	assert.NoError(t, err)
+	assert.Equal(t, "widget", rc.ProjectName)
+	assert.Equal(t, []string{"doc", "review"}, rc.Find("alice", "").Contributions)
+	assert.Equal(t, "Dave", rc.Find("", "Dave").Name)
+	assert.Nil(t, rc.Find("bob", "Dave"))
+
+	rc.Contributors = append(rc.Contributors, AllContributor{Login: "bob", Contributions: []string{"code"}})
+	assert.NoError(t, rc.Save(filename))
+	rc, err = LoadAllContributors(filename)
+	assert.NoError(t, err)
+	assert.Len(t, rc.Contributors, 3)
+	data, err := os.ReadFile(filename)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"badgeTemplate": "<custom>"`)
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package cfg
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// AuthorsFile is the default name of the people file, a .json file
+// is read and written as JSON.
+const AuthorsFile = ".authors.yaml"
+
+// Authors is the people file of "docwiz authors", it records the people
+// confirmed by "docwiz authors --auto" so that the next runs reuse them.
+// The other templates read the people from it too.
+type Authors struct {
+	Maintainers         []Person `yaml:"maintainers" json:"maintainers"`
+	Contributors        []Person `yaml:"contributors" json:"contributors"`
+	SpecialContributors []Person `yaml:"specialContributors,omitempty" json:"specialContributors,omitempty"`
+
+	// Excluded lists the emails (or names) of the people left out,
+	// they aren't proposed again.
+	Excluded []string `yaml:"excluded,omitempty" json:"excluded,omitempty"`
+}
+
+// LoadAuthors reads and validates the people file, YAML or JSON.
+func LoadAuthors(filename string) (*Authors, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	authors := &Authors{}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err = dec.Decode(authors); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	if err = authors.Validate(); err != nil {
+		return nil, err
+	}
+	return authors, nil
+}
+
+// Validate checks the people and reports the ones listed twice.
+func (a *Authors) Validate() error {
+	var errs []error
+	seen := make(map[string]string)
+	lists := []struct {
+		name   string
+		people []Person
+	}{
+		{"maintainers", a.Maintainers},
+		{"contributors", a.Contributors},
+		{"specialContributors", a.SpecialContributors},
+	}
+	for _, list := range lists {
+		for i, p := range list.people {
+			at := fmt.Sprintf("%s[%d] %s", list.name, i, p.Name)
+			if err := p.Validate(); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", at, err))
+			}
+			key := p.Key()
+			if len(key) == 0 {
+				continue
+			}
+			if prev, ok := seen[key]; ok {
+				errs = append(errs, fmt.Errorf("%s: already listed as %s", at, prev))
+			}
+			seen[key] = at
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Find returns the person with the email, the login or, without
+// both, the name. The lists are searched in order.
+func (a *Authors) Find(email, login, name string) *Person {
+	for _, list := range [][]Person{a.Maintainers, a.Contributors, a.SpecialContributors} {
+		for i := range list {
+			p := &list[i]
+			if len(email) != 0 && p.HasEmail(email) ||
+				len(login) != 0 && strings.EqualFold(p.Login, login) ||
+				len(email) == 0 && len(login) == 0 && p.Name == name {
+				return p
+			}
+		}
+	}
+	return nil
+}
+
+// Excludes reports whether the person was left out.
+func (a *Authors) Excludes(key string) bool {
+	for _, excluded := range a.Excluded {
+		if strings.EqualFold(excluded, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// All returns the people of the lists, the maintainers first.
+func (a *Authors) All() []Person {
+	return slices.Concat(a.Maintainers, a.Contributors, a.SpecialContributors)
+}
+
+// Save writes the people file, as JSON when its extension is .json.
+func (a *Authors) Save(filename string) error {
+	if filepath.Ext(filename) == ".json" {
+		data, err := json.MarshalIndent(a, "", "  ")
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(filename, append(data, '\n'), 0644)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("# The people of the AUTHORS file, edit it or run \"docwiz authors --auto\" to update it.\n")
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(a); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+	return os.WriteFile(filename, buf.Bytes(), 0644)
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package cfg
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthors(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), AuthorsFile)
+	authors := &Authors{
+		Maintainers: []Person{{Name: "Alice", Emails: []string{"Alice@example.com", "alice@work.example"}, Login: "alice", Role: "Lead Developer, Reviewer"}},
+		Contributors: []Person{
+			{Name: "Bob", Emails: []string{"bob@example.com"}, Handles: map[string]string{"twitter": "@bob"}, Since: "2024-03"},
+			{Name: "Dave"},
+		},
+		SpecialContributors: []Person{{Name: "ACME", Kind: PersonOrganization, HomePage: "https://acme.example"}},
+		Excluded:            []string{"carol@example.com"},
+	}
+	assert.NoError(t, authors.Save(filename))
+
+	loaded, err := LoadAuthors(filename)
+	assert.NoError(t, err)
+	assert.Equal(t, authors, loaded)
+	assert.Equal(t, "Lead Developer, Reviewer", loaded.Find("alice@work.example", "", "").Role)
+	assert.Equal(t, "Alice", loaded.Find("", "ALICE", "").Name)
+	assert.Equal(t, "Dave", loaded.Find("", "", "Dave").Name)
+	assert.Nil(t, loaded.Find("dave@example.com", "", "Dave"))
+	assert.True(t, loaded.Excludes("Carol@example.com"))
+	assert.Equal(t, "alice@example.com", loaded.Maintainers[0].Key())
+
+	assert.True(t, loaded.SpecialContributors[0].IsOrganization())
+
+	jsonFile := filepath.Join(t.TempDir(), "people.json")
+	assert.NoError(t, authors.Save(jsonFile))
+	loaded, err = LoadAuthors(jsonFile)
+	assert.NoError(t, err)
+	assert.Equal(t, authors, loaded)
+
+	_, err = LoadAuthors(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestLoadAuthorsLegacy(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), AuthorsFile)
+	assert.NoError(t, os.WriteFile(filename, []byte("maintainers:\n  - name: Alice\n    email: alice@example.com\n    duty: Lead Developer\n"), 0644))
+	authors, err := LoadAuthors(filename)
+	assert.NoError(t, err)
+	assert.Equal(t, []Person{{Name: "Alice", Emails: []string{"alice@example.com"}, Role: "Lead Developer"}}, authors.Maintainers)
+}
+
+func TestValidateAuthors(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), AuthorsFile)
+	assert.NoError(t, os.WriteFile(filename, []byte("maintainers:\n  - name: Alice\n    emial: alice@example.com\n"), 0644))
+	_, err := LoadAuthors(filename)
+	assert.ErrorContains(t, err, `line 3: unknown field "emial"`)
+
+	assert.NoError(t, os.WriteFile(filename, []byte("maintainer:\n  - name: Alice\n"), 0644))
+	_, err = LoadAuthors(filename)
+	assert.Error(t, err)
+
+	authors := &Authors{
+		Maintainers: []Person{
+			{Name: "Alice", Emails: []string{"alice@example.com"}, Kind: "company", Profile: "github.com/alice"},
+			{Name: "Bob", Emails: []string{"bob"}, Since: "2024-05-01", Until: "2023"},
+		},
+		Contributors: []Person{{Emails: []string{"ALICE@example.com"}}},
+	}
+	err = authors.Validate()
+	for _, msg := range []string{
+		`maintainers[0] Alice: kind "company" must be individual or organization`,
+		`profile "github.com/alice" must be an http(s) URL`,
+		`maintainers[1] Bob: invalid email "bob"`,
+		`until 2023 is before since 2024-05-01`,
+		`contributors[0] : the name is missing`,
+		`already listed as maintainers[0] Alice`,
+	} {
+		assert.ErrorContains(t, err, msg)
+	}
+}
+
+func TestParsePerson(t *testing.T) {
+	p, err := ParsePerson(`{name: Alice, role: "Lead Developer, Reviewer", homepage: "https://alice.example/?a=1,b=2"}`)
+	assert.NoError(t, err)
+	assert.Equal(t, Person{Name: "Alice", Role: "Lead Developer, Reviewer", HomePage: "https://alice.example/?a=1,b=2"}, p)
+
+	p, err = ParsePerson(`{"name": "ACME", "kind": "organization"}`)
+	assert.NoError(t, err)
+	assert.True(t, p.IsOrganization())
+
+	p, err = ParsePerson("Bob")
+	assert.NoError(t, err)
+	assert.Equal(t, "Bob", p.Name)
+
+	_, err = ParsePerson(`{name: Carol, emails: [carol]}`)
+	assert.Error(t, err)
+}
@@ -0,0 +1,256 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package cfg
+
+import (
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DocWizConfigFile is the default name of the project-level configuration file.
+const DocWizConfigFile = ".docwiz.yaml"
+
+// DocWizConfig holds the project-level settings read from .docwiz.yaml.
+// Every section is optional, missing values fall back to the defaults
+// returned by DefaultDocWizConfig.
+type DocWizConfig struct {
+	Badge      BadgeConfig      `yaml:"badge"`
+	Changelog  ChangelogConfig  `yaml:"changelog"`
+	CodeOwners CodeOwnersConfig `yaml:"codeowners"`
+	Commit     CommitConfig     `yaml:"commit"`
+	Emoji      EmojiConfig      `yaml:"emoji"`
+	Git        GitConfig        `yaml:"git"`
+	Identity   IdentityConfig   `yaml:"identity"`
+}
+
+// BadgeConfig controls which technology badges make it into the generated stack.
+type BadgeConfig struct {
+	// Max limits the number of badges in the stack, 0 means unlimited.
+	Max int `yaml:"max"`
+
+	// MinFiles is the minimum number of files a technology must appear in
+	// to be kept, unless it is declared in a manifest.
+	MinFiles int `yaml:"minFiles"`
+
+	// MinLines is the minimum number of lines of code a technology must have
+	// to be kept, unless it is declared in a manifest.
+	MinLines int `yaml:"minLines"`
+
+	// MinShare is the minimum share (0-1) of the project's lines of code a
+	// technology must reach to be kept, unless it is declared in a manifest.
+	MinShare float64 `yaml:"minShare"`
+
+	// Version is the default display policy of dependency versions:
+	// exact, major.minor, major or hide.
+	Version string `yaml:"version"`
+
+	// Versions overrides the display policy per badge, e.g. {React: major}.
+	Versions map[string]string `yaml:"versions"`
+
+	// Format is the markup of the generated badges: markdown, html, rst or asciidoc.
+	Format string `yaml:"format"`
+
+	// Picture renders HTML badges as <picture> elements switching to
+	// their dark variant with prefers-color-scheme.
+	Picture bool `yaml:"picture"`
+
+	// Palette recolors every badge, e.g. to match a brand.
+	Palette PaletteConfig `yaml:"palette"`
+
+	// Extra declares custom badges added to the stack.
+	Extra []ShieldConfig `yaml:"extra"`
+}
+
+// ShieldConfig declares a custom shields.io badge, Logo is a Simple Icons slug.
+type ShieldConfig struct {
+	ID        string `yaml:"id"`
+	Label     string `yaml:"label"`
+	Message   string `yaml:"message"`
+	Color     string `yaml:"color"`
+	Logo      string `yaml:"logo"`
+	LogoColor string `yaml:"logoColor"`
+	Href      string `yaml:"href"`
+}
+
+// PaletteConfig overrides the badge colors, empty fields keep the badge's own colors.
+type PaletteConfig struct {
+	Color         string `yaml:"color"`
+	LogoColor     string `yaml:"logoColor"`
+	DarkColor     string `yaml:"darkColor"`
+	DarkLogoColor string `yaml:"darkLogoColor"`
+}
+
+// GitConfig describes where the repository is hosted.
+type GitConfig struct {
+	// Forges maps the hosts of self-hosted instances to their forge:
+	// github, gitlab, gitea, bitbucket or azure, e.g. {git.example.com: gitlab}.
+	Forges map[string]string `yaml:"forges"`
+
+	// Remotes lists the remotes describing the project in order of
+	// preference, the default is [upstream, origin].
+	Remotes []string `yaml:"remotes"`
+}
+
+// IdentityConfig resolves the people of the history on top of .mailmap.
+type IdentityConfig struct {
+	// Bots lists the glob patterns of bot names or emails left out of the
+	// contributors, nil uses the default list (dependabot, renovate, *[bot], ...).
+	Bots []string `yaml:"bots"`
+
+	// Logins maps emails or names to forge usernames, for the people whose
+	// profile can't be deduced from their email, e.g. {alice@corp.com: alice}.
+	Logins map[string]string `yaml:"logins"`
+}
+
+// ChangelogConfig controls how commits are grouped in the changelog.
+type ChangelogConfig struct {
+	// Sections maps commit types to sections in display order, e.g.
+	// [{title: Features, types: [feat]}]. Empty uses the default sections.
+	Sections []ChangelogSection `yaml:"sections"`
+
+	// Hidden lists the commit types left out of the changelog,
+	// nil uses the default list (chore, ci, build, style, test).
+	Hidden []string `yaml:"hidden"`
+
+	// Breaking is the title of the breaking changes section, an empty
+	// string turns the section off and leaves breaking changes in the
+	// section of their type.
+	Breaking *string `yaml:"breaking"`
+
+	// Other is the title of the section collecting the remaining commits,
+	// an empty string hides them.
+	Other *string `yaml:"other"`
+
+	// TagPattern keeps the release tags matching the glob, e.g. "api/v*"
+	// for the api module of a monorepo.
+	TagPattern string `yaml:"tagPattern"`
+
+	// SkipPrereleases merges the prerelease tags into the next stable release.
+	SkipPrereleases bool `yaml:"skipPrereleases"`
+
+	// FirstParent only lists the first-parent history, merged pull requests
+	// are listed once by their title.
+	FirstParent bool `yaml:"firstParent"`
+}
+
+// CommitConfig holds the rules of the commit messages checked by "docwiz commit lint".
+type CommitConfig struct {
+	// Types lists the allowed commit types, nil uses the default list
+	// (feat, fix, docs, style, refactor, perf, test, build, ci, chore, revert).
+	Types []string `yaml:"types"`
+
+	// Scopes lists the allowed scopes, empty allows any scope.
+	Scopes []string `yaml:"scopes"`
+
+	// RequireScope rejects the messages without scope.
+	RequireScope bool `yaml:"requireScope"`
+
+	// MaxSubjectLength limits the length of the subject, 0 means unlimited.
+	MaxSubjectLength int `yaml:"maxSubjectLength"`
+
+	// SubjectCase is lower, sentence or empty for any case.
+	SubjectCase string `yaml:"subjectCase"`
+
+	// Footers lists the footers every message must have, e.g. [Signed-off-by].
+	Footers []string `yaml:"footers"`
+}
+
+// EmojiConfig extends the emojis of the commit command and the templates,
+// the emojis are either emojis or gitmoji codes like ":arrow_up:".
+type EmojiConfig struct {
+	// Types maps commit types to their emoji, e.g. {deps: ":arrow_up:"}.
+	Types map[string]string `yaml:"types"`
+
+	// Keywords decorate the messages that don't follow Conventional Commits.
+	Keywords []EmojiKeyword `yaml:"keywords"`
+}
+
+// EmojiKeyword maps a word of the messages to an emoji, a trailing "*"
+// matches the words starting with it. Without priority, it wins over
+// the built-in keywords.
+type EmojiKeyword struct {
+	Keyword  string `yaml:"keyword"`
+	Emoji    string `yaml:"emoji"`
+	Priority int    `yaml:"priority"`
+}
+
+// CodeOwnersConfig controls the CODEOWNERS file generated by "docwiz codeowners".
+type CodeOwnersConfig struct {
+	// Threshold is the minimum share (0-1) of the commits and lines of a
+	// directory making a person one of its owners.
+	Threshold float64 `yaml:"threshold"`
+
+	// MinOwners completes the owners above the threshold with the next
+	// biggest shares, MaxOwners keeps the biggest ones, 0 means unlimited.
+	MinOwners int `yaml:"minOwners"`
+	MaxOwners int `yaml:"maxOwners"`
+
+	// Depth is the depth of the directories getting their own rule.
+	Depth int `yaml:"depth"`
+
+	// Days is the period of the counted commits, 0 counts the whole history.
+	Days int `yaml:"days"`
+
+	// BlameWeight is the weight (0-1) of the line authorship in the share,
+	// the rest is the commit share. 0 skips the blame.
+	BlameWeight float64 `yaml:"blameWeight"`
+
+	// Teams maps the team handles to their members, logins or emails, e.g.
+	// {"@acme/docs": [alice, bob@example.com]}. The members are written as their team.
+	Teams map[string][]string `yaml:"teams"`
+
+	// Pinned are the rules written as is, they win over the generated ones.
+	Pinned []CodeOwnersRule `yaml:"pinned"`
+}
+
+// CodeOwnersRule assigns the files matching the pattern to their owners.
+type CodeOwnersRule struct {
+	Pattern string   `yaml:"pattern"`
+	Owners  []string `yaml:"owners"`
+}
+
+// ChangelogSection is a changelog section and the commit types it lists.
+type ChangelogSection struct {
+	Title string   `yaml:"title"`
+	Types []string `yaml:"types"`
+}
+
+// DefaultDocWizConfig returns the configuration used when no .docwiz.yaml exists.
+func DefaultDocWizConfig() *DocWizConfig {
+	return &DocWizConfig{
+		Badge: BadgeConfig{
+			MinFiles: 2,
+			MinShare: 0.05,
+		},
+		CodeOwners: CodeOwnersConfig{
+			Threshold:   0.2,
+			MinOwners:   1,
+			MaxOwners:   3,
+			Depth:       2,
+			Days:        365,
+			BlameWeight: 0.5,
+		},
+		Commit: CommitConfig{
+			MaxSubjectLength: 72,
+		},
+	}
+}
+
+// LoadDocWizConfig reads the configuration from filename on top of the defaults.
+// Like LoadDocWizIgnore, it always returns a usable configuration, the error
+// only reports why the file couldn't be applied.
+func LoadDocWizConfig(filename string) (*DocWizConfig, error) {
+	conf := DefaultDocWizConfig()
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return conf, err
+	}
+
+	if err = yaml.Unmarshal(data, conf); err != nil {
+		return DefaultDocWizConfig(), err
+	}
+	return conf, nil
+}
@@ -0,0 +1,215 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package cfg
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// PersonIndividual is the kind of the people, the default.
+	PersonIndividual = "individual"
+
+	// PersonOrganization is the kind of the companies, foundations or
+	// communities backing the project.
+	PersonOrganization = "organization"
+)
+
+// Person is an individual or an organization of the people file, it's
+// shared by the AUTHORS, contributors, security and code of conduct
+// templates.
+type Person struct {
+	Name string `yaml:"name" json:"name"`
+
+	// Kind is individual (the default) or organization.
+	Kind string `yaml:"kind,omitempty" json:"kind,omitempty"`
+
+	// Emails are the addresses of the person, the first one is the
+	// contact, the others match the commits made with them.
+	Emails []string `yaml:"emails,omitempty" json:"emails,omitempty"`
+
+	// Login is the username on the forge.
+	Login string `yaml:"login,omitempty" json:"login,omitempty"`
+
+	// Handles are the accounts on the other services, e.g. twitter: "@bob".
+	Handles map[string]string `yaml:"handles,omitempty" json:"handles,omitempty"`
+
+	// Role is the duty of the person, e.g. "Lead Developer".
+	Role string `yaml:"role,omitempty" json:"role,omitempty"`
+
+	// Org is the organization the person works for.
+	Org string `yaml:"org,omitempty" json:"org,omitempty"`
+
+	HomePage string `yaml:"homepage,omitempty" json:"homepage,omitempty"`
+	Profile  string `yaml:"profile,omitempty" json:"profile,omitempty"`
+	Avatar   string `yaml:"avatar,omitempty" json:"avatar,omitempty"`
+
+	// Since and Until are the dates (2006-01-02, 2006-01 or 2006) the
+	// person joined and left the project.
+	Since string `yaml:"since,omitempty" json:"since,omitempty"`
+	Until string `yaml:"until,omitempty" json:"until,omitempty"`
+
+	// Others holds the custom fields listed under the person.
+	Others map[string]string `yaml:"others,omitempty" json:"others,omitempty"`
+}
+
+// personFields are the keys of a person, the other keys are typos.
+// email and duty are the keys of the first version of the file.
+var personFields = []string{
+	"name", "kind", "emails", "email", "login", "handles", "role", "duty", "org",
+	"homepage", "profile", "avatar", "since", "until", "others",
+}
+
+// personDateLayouts are the accepted layouts of since and until.
+var personDateLayouts = []string{"2006-01-02", "2006-01", "2006"}
+
+// UnmarshalYAML decodes a person, rejecting the unknown keys and
+// reading the email and duty keys of the first version of the file.
+// JSON being YAML, it decodes the JSON people files as well.
+func (p *Person) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: a person must be a mapping", value.Line)
+	}
+	for i := 0; i < len(value.Content); i += 2 {
+		key := value.Content[i]
+		if !slices.Contains(personFields, key.Value) {
+			return fmt.Errorf("line %d: unknown field %q, the custom fields go under others", key.Line, key.Value)
+		}
+	}
+
+	type plain Person
+	var legacy struct {
+		plain `yaml:",inline"`
+		Email string `yaml:"email"`
+		Duty  string `yaml:"duty"`
+	}
+	if err := value.Decode(&legacy); err != nil {
+		return err
+	}
+	*p = Person(legacy.plain)
+	if len(legacy.Email) != 0 && !slices.Contains(p.Emails, legacy.Email) {
+		p.Emails = append([]string{legacy.Email}, p.Emails...)
+	}
+	if len(p.Role) == 0 {
+		p.Role = legacy.Duty
+	}
+	return nil
+}
+
+// Email returns the contact address, empty when unknown.
+func (p Person) Email() string {
+	if len(p.Emails) == 0 {
+		return ""
+	}
+	return p.Emails[0]
+}
+
+// IsOrganization reports whether the person is an organization.
+func (p Person) IsOrganization() bool {
+	return p.Kind == PersonOrganization
+}
+
+// IsIndividual reports whether the person is an individual.
+func (p Person) IsIndividual() bool {
+	return !p.IsOrganization()
+}
+
+// Active reports whether the person is still part of the project.
+func (p Person) Active() bool {
+	return len(p.Until) == 0
+}
+
+// Key identifies the person like git.Identity, the lower case email
+// or the name without email.
+func (p Person) Key() string {
+	if email := p.Email(); len(email) != 0 {
+		return strings.ToLower(email)
+	}
+	return strings.ToLower(p.Name)
+}
+
+// HasEmail reports whether one of the addresses is email.
+func (p Person) HasEmail(email string) bool {
+	return slices.ContainsFunc(p.Emails, func(e string) bool { return strings.EqualFold(e, email) })
+}
+
+// Validate checks the fields of the person and returns all the problems.
+func (p Person) Validate() error {
+	var errs []error
+	if len(strings.TrimSpace(p.Name)) == 0 {
+		errs = append(errs, errors.New("the name is missing"))
+	}
+	if len(p.Kind) != 0 && p.Kind != PersonIndividual && p.Kind != PersonOrganization {
+		errs = append(errs, fmt.Errorf("kind %q must be %s or %s", p.Kind, PersonIndividual, PersonOrganization))
+	}
+	for _, email := range p.Emails {
+		if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+			errs = append(errs, fmt.Errorf("invalid email %q", email))
+		}
+	}
+	for field, link := range map[string]string{"homepage": p.HomePage, "profile": p.Profile, "avatar": p.Avatar} {
+		if len(link) == 0 {
+			continue
+		}
+		if u, err := url.Parse(link); err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+			errs = append(errs, fmt.Errorf("%s %q must be an http(s) URL", field, link))
+		}
+	}
+
+	since, err := parsePersonDate(p.Since)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("since: %w", err))
+	}
+	until, err := parsePersonDate(p.Until)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("until: %w", err))
+	}
+	if !since.IsZero() && !until.IsZero() && until.Before(since) {
+		errs = append(errs, fmt.Errorf("until %s is before since %s", p.Until, p.Since))
+	}
+	return errors.Join(errs...)
+}
+
+// parsePersonDate parses since and until, the empty date is the zero time.
+func parsePersonDate(date string) (time.Time, error) {
+	if len(date) == 0 {
+		return time.Time{}, nil
+	}
+	for _, layout := range personDateLayouts {
+		if t, err := time.Parse(layout, date); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q, use 2006-01-02, 2006-01 or 2006", date)
+}
+
+// legacyPersonRegex matches the former key=value syntax of the people,
+// e.g. `name=Alice,duty="Lead Developer"`.
+var legacyPersonRegex = regexp.MustCompile(`^\w+\s*=`)
+
+// ParsePerson reads a person given on the command line as a YAML or JSON
+// object, e.g. `{name: Alice, role: "Lead Developer, Reviewer"}`. A plain
+// value is the name of the person, the former key=value syntax is rejected.
+func ParsePerson(v string) (Person, error) {
+	var p Person
+	v = strings.TrimSpace(v)
+	if legacyPersonRegex.MatchString(v) {
+		return p, fmt.Errorf("the key=value syntax is no longer supported, write the person as a YAML object, e.g. {name: Alice, role: \"Lead Developer\"}")
+	}
+	if !strings.HasPrefix(v, "{") {
+		p.Name = v
+	} else if err := yaml.Unmarshal([]byte(v), &p); err != nil {
+		return p, err
+	}
+	return p, p.Validate()
+}
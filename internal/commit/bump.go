@@ -0,0 +1,60 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package commit
+
+import "github.com/Masterminds/semver/v3"
+
+// Bump is the part of the version a set of commits increments.
+type Bump int
+
+const (
+	BumpNone Bump = iota
+	BumpPatch
+	BumpMinor
+	BumpMajor
+)
+
+func (b Bump) String() string {
+	switch b {
+	case BumpPatch:
+		return "patch"
+	case BumpMinor:
+		return "minor"
+	case BumpMajor:
+		return "major"
+	}
+	return "none"
+}
+
+// BumpOf returns the bump implied by a commit: breaking changes are major,
+// features minor and fixes or performance improvements patch. Other types
+// (docs, chore, ...) don't call for a release.
+func BumpOf(m Message) Bump {
+	switch {
+	case m.Breaking:
+		return BumpMajor
+	case m.Type == "feat":
+		return BumpMinor
+	case m.Type == "fix" || m.Type == "perf":
+		return BumpPatch
+	}
+	return BumpNone
+}
+
+// Apply increments v. Before 1.0.0 the public API isn't stable,
+// so breaking changes only increment the minor version.
+func (b Bump) Apply(v semver.Version) semver.Version {
+	switch b {
+	case BumpMajor:
+		if v.Major() == 0 {
+			return v.IncMinor()
+		}
+		return v.IncMajor()
+	case BumpMinor:
+		return v.IncMinor()
+	case BumpPatch:
+		return v.IncPatch()
+	}
+	return v
+}
@@ -0,0 +1,215 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package commit
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Footer is a git trailer like "Reviewed-by: Alice" or "Refs #12".
+type Footer struct {
+	Token string
+	Value string
+}
+
+// Message is a commit message parsed according to the Conventional Commits
+// specification (https://www.conventionalcommits.org/en/v1.0.0/).
+type Message struct {
+	// Header is the first line of the message without its emoji prefix.
+	Header string
+
+	// Emoji is the first emoji or gitmoji shortcode prefixing the header.
+	Emoji string
+
+	// Type is the lower case type, e.g. "feat" or "fix". It's empty
+	// when the header doesn't follow the specification.
+	Type string
+
+	// Scope is the optional noun between parentheses, e.g. "parser".
+	Scope string
+
+	// Subject is the description after the colon, or the whole header
+	// for messages that don't follow the specification.
+	Subject string
+
+	// Body is the free-form text between the header and the footers.
+	Body string
+
+	Footers []Footer
+
+	// Breaking reports a breaking change, marked by "!" after the
+	// type/scope or a "BREAKING CHANGE" footer.
+	Breaking bool
+
+	// BreakingNote is the description of the BREAKING CHANGE footer.
+	BreakingNote string
+}
+
+// Conventional reports whether the header follows the specification.
+func (m Message) Conventional() bool {
+	return len(m.Type) != 0
+}
+
+// String formats the message, the inverse of Parse: the header, the body
+// and the footers separated by blank lines. The BreakingNote is written as
+// a BREAKING CHANGE footer unless Footers already has one.
+func (m Message) String() string {
+	header := m.Subject
+	if m.Conventional() {
+		var sb strings.Builder
+		sb.WriteString(m.Type)
+		if len(m.Scope) != 0 {
+			sb.WriteString("(" + m.Scope + ")")
+		}
+		if m.Breaking {
+			sb.WriteString("!")
+		}
+		sb.WriteString(": " + m.Subject)
+		header = sb.String()
+	}
+
+	footers := m.Footers
+	if len(m.BreakingNote) != 0 && !slices.ContainsFunc(footers, func(f Footer) bool {
+		return f.Token == "BREAKING CHANGE" || f.Token == "BREAKING-CHANGE"
+	}) {
+		footers = append([]Footer{{Token: "BREAKING CHANGE", Value: m.BreakingNote}}, footers...)
+	}
+
+	paragraphs := []string{header}
+	if body := strings.TrimSpace(m.Body); len(body) != 0 {
+		paragraphs = append(paragraphs, body)
+	}
+	if len(footers) != 0 {
+		var lines []string
+		for _, f := range footers {
+			// issue references like "Closes #12" are parsed without their "#"
+			if issueRegex.MatchString(f.Value) {
+				lines = append(lines, f.Token+" #"+f.Value)
+			} else {
+				lines = append(lines, f.Token+": "+f.Value)
+			}
+		}
+		paragraphs = append(paragraphs, strings.Join(lines, "\n"))
+	}
+	return strings.Join(paragraphs, "\n\n")
+}
+
+var (
+	// headerRegex matches "type(scope)!: subject".
+	headerRegex = regexp.MustCompile(`^([a-zA-Z]+)(?:\(([^()\r\n]*)\))?(!)?: +(.+)$`)
+
+	// footerRegex matches "Token: value" and "Token #value" trailers,
+	// tokens use dashes instead of spaces except for BREAKING CHANGE.
+	footerRegex = regexp.MustCompile(`^(BREAKING CHANGE|BREAKING-CHANGE|[\w-]+)(?:: | #)(.*)$`)
+
+	// issueRegex matches the values of issue footers.
+	issueRegex = regexp.MustCompile(`^\d+$`)
+
+	// shortcodeRegex matches gitmoji shortcodes like ":sparkles:".
+	shortcodeRegex = regexp.MustCompile(`^:[a-z0-9_+-]+:`)
+)
+
+// Parse parses a raw commit message. It never fails, messages that don't
+// follow the specification only have their Header, Subject and Body set.
+func Parse(raw string) Message {
+	raw = strings.ReplaceAll(raw, "\r\n", "\n")
+	header, rest, _ := strings.Cut(strings.TrimSpace(raw), "\n")
+	emoji, header := splitEmoji(strings.TrimSpace(header))
+
+	m := Message{Header: header, Subject: header, Emoji: emoji}
+	if match := headerRegex.FindStringSubmatch(header); match != nil {
+		m.Type = strings.ToLower(match[1])
+		m.Scope = strings.TrimSpace(match[2])
+		m.Breaking = len(match[3]) != 0
+		m.Subject = strings.TrimSpace(match[4])
+	}
+
+	m.Body, m.Footers = splitFooters(strings.TrimSpace(rest))
+	for _, f := range m.Footers {
+		if f.Token == "BREAKING CHANGE" || f.Token == "BREAKING-CHANGE" {
+			m.Breaking = true
+			m.BreakingNote = f.Value
+		}
+	}
+	return m
+}
+
+// splitFooters separates the trailing footer paragraph from the body.
+func splitFooters(text string) (string, []Footer) {
+	if len(text) == 0 {
+		return "", nil
+	}
+
+	paragraphs := strings.Split(text, "\n\n")
+	last := paragraphs[len(paragraphs)-1]
+	lines := strings.Split(last, "\n")
+	if !footerRegex.MatchString(lines[0]) {
+		return text, nil
+	}
+
+	var footers []Footer
+	for _, line := range lines {
+		if match := footerRegex.FindStringSubmatch(line); match != nil {
+			footers = append(footers, Footer{Token: match[1], Value: strings.TrimSpace(match[2])})
+			continue
+		}
+		// continuation of a multi-line footer value
+		f := &footers[len(footers)-1]
+		f.Value = strings.TrimSpace(f.Value + "\n" + line)
+	}
+	body := strings.TrimSpace(strings.Join(paragraphs[:len(paragraphs)-1], "\n\n"))
+	return body, footers
+}
+
+// StripEmoji removes the emojis and gitmoji shortcodes prefixing a header,
+// e.g. "✨ feat: x" or ":bug: fix: y", as added by the commit command.
+func StripEmoji(header string) string {
+	_, header = splitEmoji(header)
+	return header
+}
+
+// splitEmoji returns the first emoji or shortcode prefixing the header
+// and the header without its emojis.
+func splitEmoji(header string) (string, string) {
+	first := ""
+	for {
+		header = strings.TrimLeftFunc(header, unicode.IsSpace)
+		if loc := shortcodeRegex.FindStringIndex(header); loc != nil {
+			if len(first) == 0 {
+				first = header[:loc[1]]
+			}
+			header = header[loc[1]:]
+			continue
+		}
+		r := []rune(header)
+		if len(r) == 0 || !isEmoji(r[0]) {
+			return first, header
+		}
+		i := 1
+		// variation selectors, zero width joiners and the joined emojis
+		for i < len(r) && (isEmoji(r[i]) || r[i] == 0xFE0F || r[i] == 0x200D) {
+			i++
+		}
+		if len(first) == 0 {
+			first = string(r[:i])
+		}
+		header = string(r[i:])
+	}
+}
+
+func isEmoji(r rune) bool {
+	switch {
+	case r >= 0x1F000 && r <= 0x1FAFF, // pictographs, emoticons, transport, ...
+		r >= 0x2600 && r <= 0x27BF, // misc symbols and dingbats
+		r >= 0x2190 && r <= 0x21FF, // arrows
+		r >= 0x2B00 && r <= 0x2BFF, // misc symbols and arrows
+		r >= 0x2300 && r <= 0x23FF, // misc technical
+		r == 0x203C || r == 0x2049 || r == 0x2122 || r == 0x2139 || r == 0x3030 || r == 0x303D:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package commit
+
+import (
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	m := Parse("feat(parser): support arrays\n\nArrays are parsed lazily.\n\nReviewed-by: Z\nRefs #133\n")
+	assert.Equal(t, "feat", m.Type)
+	assert.Equal(t, "parser", m.Scope)
+	assert.Equal(t, "support arrays", m.Subject)
+	assert.Equal(t, "Arrays are parsed lazily.", m.Body)
+	assert.Equal(t, []Footer{{Token: "Reviewed-by", Value: "Z"}, {Token: "Refs", Value: "133"}}, m.Footers)
+	assert.False(t, m.Breaking)
+
+	m = Parse("refactor!: drop Node 6")
+	assert.Equal(t, "refactor", m.Type)
+	assert.True(t, m.Breaking)
+
+	m = Parse("fix: prevent racing\n\nBREAKING CHANGE: the config key\nis renamed")
+	assert.True(t, m.Breaking)
+	assert.Equal(t, "the config key\nis renamed", m.BreakingNote)
+	assert.Empty(t, m.Body)
+
+	m = Parse("🔧 ✨ Feat(api): add endpoint")
+	assert.Equal(t, "feat", m.Type)
+	assert.Equal(t, "api", m.Scope)
+	assert.Equal(t, "Feat(api): add endpoint", m.Header)
+	assert.Equal(t, "🔧", m.Emoji)
+
+	m = Parse(":bug: fix: off by one")
+	assert.Equal(t, ":bug:", m.Emoji)
+	assert.Equal(t, "fix", m.Type)
+	assert.Equal(t, "off by one", m.Subject)
+
+	m = Parse("Merge branch 'main'\n\nsome body")
+	assert.False(t, m.Conventional())
+	assert.Equal(t, "Merge branch 'main'", m.Subject)
+	assert.Equal(t, "some body", m.Body)
+}
+
+func TestMessageString(t *testing.T) {
+	m := Message{
+		Type:         "feat",
+		Scope:        "parser",
+		Subject:      "support arrays",
+		Body:         "Arrays are parsed lazily.",
+		Breaking:     true,
+		BreakingNote: "the config key is renamed",
+		Footers:      []Footer{{Token: "Closes", Value: "12"}, {Token: "Co-authored-by", Value: "Bob <bob@example.com>"}},
+	}
+	raw := m.String()
+	assert.Equal(t, "feat(parser)!: support arrays\n\nArrays are parsed lazily.\n\n"+
+		"BREAKING CHANGE: the config key is renamed\nCloses #12\nCo-authored-by: Bob <bob@example.com>", raw)
+
+	parsed := Parse(raw)
+	assert.Equal(t, raw, parsed.String())
+	assert.Equal(t, "the config key is renamed", parsed.BreakingNote)
+	assert.Equal(t, "update readme", Parse("update readme").String())
+}
+
+func TestClassify(t *testing.T) {
+	c := DefaultClassifier()
+	for _, tt := range []struct {
+		message string
+		section string
+		visible bool
+	}{
+		{"feat: a", SectionFeatures, true},
+		{"fix(db): b", SectionBugFixes, true},
+		{"perf: c", SectionPerformance, true},
+		{"chore: d", "", false},
+		{"chore!: e", SectionBreaking, true},
+		{"docs: f", SectionOther, true},
+		{"update readme", SectionOther, true},
+	} {
+		section, visible := c.Classify(Parse(tt.message))
+		assert.Equal(t, tt.section, section, tt.message)
+		assert.Equal(t, tt.visible, visible, tt.message)
+	}
+	assert.Equal(t, []string{SectionBreaking, SectionFeatures, SectionBugFixes, SectionPerformance, SectionOther}, c.Titles())
+}
+
+func TestBump(t *testing.T) {
+	testCases := []struct {
+		messages []string
+		current  string
+		expected string
+	}{
+		{[]string{"docs: readme", "chore: deps"}, "1.2.3", "1.2.3"},
+		{[]string{"fix: a", "docs: b"}, "1.2.3", "1.2.4"},
+		{[]string{"fix: a", "feat: b"}, "1.2.3", "1.3.0"},
+		{[]string{"feat: a", "fix!: b"}, "1.2.3", "2.0.0"},
+		{[]string{"refactor: a\n\nBREAKING CHANGE: b"}, "1.2.3", "2.0.0"},
+		{[]string{"feat!: a"}, "0.4.1", "0.5.0"},
+		{[]string{"feat: a"}, "0.4.1", "0.5.0"},
+		{[]string{"perf: a"}, "0.4.1", "0.4.2"},
+	}
+	for _, tc := range testCases {
+		bump := BumpNone
+		for _, m := range tc.messages {
+			if b := BumpOf(Parse(m)); b > bump {
+				bump = b
+			}
+		}
+		assert.Equal(t, tc.expected, bump.Apply(*semver.MustParse(tc.current)).String(), tc.messages)
+	}
+}
+
+func TestLint(t *testing.T) {
+	rules := Rules{MaxSubjectLength: 20, SubjectCase: CaseLower, Scopes: []string{"api", "cli"}}
+	rules2 := rules
+	rules2.Footers = []string{"Signed-off-by"}
+
+	rulesOf := func(violations []Violation) []string {
+		var names []string
+		for _, v := range violations {
+			names = append(names, v.Rule)
+		}
+		return names
+	}
+	assert.Empty(t, Lint("✨ feat(api): add endpoint\n# Please enter the commit message\n", rules))
+	assert.Empty(t, Lint("Merge branch 'main' into dev", rules))
+	assert.Empty(t, Lint("fix(api,cli): crash\n\nSigned-off-by: A <a@b.c>", rules2))
+	assert.Equal(t, []string{"header-empty"}, rulesOf(Lint("# only comments\n", rules)))
+	assert.Equal(t, []string{"header-format"}, rulesOf(Lint("added a parser", rules)))
+	assert.Equal(t, []string{"type-case", "type-enum"}, rulesOf(Lint("Feature: x", rules)))
+	assert.Equal(t, []string{"scope-enum"}, rulesOf(Lint("feat(web): x", rules)))
+	assert.Equal(t, []string{"subject-max-length", "subject-case", "subject-full-stop"},
+		rulesOf(Lint("feat: Add a very long subject line.", rules)))
+	assert.Equal(t, []string{"footer-required"}, rulesOf(Lint("fix: x\n\nbody", rules2)))
+	assert.Equal(t, []string{"scope-empty"}, rulesOf(Lint("fix: x", Rules{RequireScope: true})))
+	assert.Empty(t, Lint("feat: x\n# ------------------------ >8 ------------------------\ndiff --git a b", rules))
+}
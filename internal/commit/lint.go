@@ -0,0 +1,166 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package commit
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DefaultTypes are the commit types of the Angular convention.
+var DefaultTypes = []string{
+	"feat", "fix", "docs", "style", "refactor", "perf",
+	"test", "build", "ci", "chore", "revert",
+}
+
+const (
+	// CaseLower requires subjects starting with a lower case letter.
+	CaseLower = "lower"
+
+	// CaseSentence requires subjects starting with an upper case letter.
+	CaseSentence = "sentence"
+)
+
+// Rules configures Lint.
+type Rules struct {
+	// Types lists the allowed types, nil uses DefaultTypes.
+	Types []string
+
+	// Scopes lists the allowed scopes, empty allows any scope.
+	Scopes []string
+
+	// RequireScope rejects the headers without scope.
+	RequireScope bool
+
+	// MaxSubjectLength limits the length of the subject in characters,
+	// 0 means unlimited.
+	MaxSubjectLength int
+
+	// SubjectCase is CaseLower, CaseSentence or empty for any case.
+	SubjectCase string
+
+	// Footers lists the footers every message must have, e.g. "Signed-off-by".
+	Footers []string
+}
+
+// Violation is a rule broken by a commit message.
+type Violation struct {
+	// Rule names the rule, e.g. "type-enum".
+	Rule    string
+	Message string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s [%s]", v.Message, v.Rule)
+}
+
+// Lint checks the message against the rules, the emoji prefix is ignored.
+// Messages written by git (merges, reverts, fixups) always pass.
+func Lint(raw string, rules Rules) []Violation {
+	raw = Clean(raw)
+	if Generated(raw) {
+		return nil
+	}
+	if len(raw) == 0 {
+		return []Violation{{Rule: "header-empty", Message: "the message is empty"}}
+	}
+
+	m := Parse(raw)
+	if !m.Conventional() {
+		return []Violation{{
+			Rule:    "header-format",
+			Message: fmt.Sprintf("%q doesn't follow the \"type(scope): subject\" format", m.Header),
+		}}
+	}
+
+	var violations []Violation
+	add := func(rule, format string, args ...any) {
+		violations = append(violations, Violation{Rule: rule, Message: fmt.Sprintf(format, args...)})
+	}
+
+	types := rules.Types
+	if types == nil {
+		types = DefaultTypes
+	}
+	if rawType := headerRegex.FindStringSubmatch(m.Header)[1]; rawType != m.Type {
+		add("type-case", "type %q must be lower case", rawType)
+	}
+	if !slices.Contains(types, m.Type) {
+		add("type-enum", "type %q isn't one of %s", m.Type, strings.Join(types, ", "))
+	}
+
+	if len(m.Scope) == 0 {
+		if rules.RequireScope {
+			add("scope-empty", "scope is required")
+		}
+	} else if len(rules.Scopes) != 0 {
+		// "feat(api,cli): ..." touches several scopes
+		for _, scope := range strings.FieldsFunc(m.Scope, func(r rune) bool { return r == ',' || r == '/' }) {
+			if scope = strings.TrimSpace(scope); !slices.Contains(rules.Scopes, scope) {
+				add("scope-enum", "scope %q isn't one of %s", scope, strings.Join(rules.Scopes, ", "))
+			}
+		}
+	}
+
+	if n := utf8.RuneCountInString(m.Subject); rules.MaxSubjectLength > 0 && n > rules.MaxSubjectLength {
+		add("subject-max-length", "subject is %d characters long, the limit is %d", n, rules.MaxSubjectLength)
+	}
+	first, _ := utf8.DecodeRuneInString(m.Subject)
+	switch rules.SubjectCase {
+	case CaseLower:
+		if unicode.IsUpper(first) {
+			add("subject-case", "subject must start with a lower case letter")
+		}
+	case CaseSentence:
+		if unicode.IsLower(first) {
+			add("subject-case", "subject must start with an upper case letter")
+		}
+	}
+	if strings.HasSuffix(m.Subject, ".") {
+		add("subject-full-stop", "subject must not end with a period")
+	}
+
+	for _, token := range rules.Footers {
+		found := slices.ContainsFunc(m.Footers, func(f Footer) bool {
+			return strings.EqualFold(f.Token, token)
+		})
+		if !found {
+			add("footer-required", "footer %q is required", token)
+		}
+	}
+	return violations
+}
+
+// scissors is the line below which git appends the diff of "git commit -v".
+const scissors = "# ------------------------ >8 ------------------------"
+
+// Clean removes what git strips from an edited message: the comment
+// lines and everything below the scissors line.
+func Clean(raw string) string {
+	raw = strings.ReplaceAll(raw, "\r\n", "\n")
+	if i := strings.Index(raw, scissors); i >= 0 {
+		raw = raw[:i]
+	}
+	var lines []string
+	for _, line := range strings.Split(raw, "\n") {
+		if !strings.HasPrefix(line, "#") {
+			lines = append(lines, line)
+		}
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// Generated reports the messages written by git or the forges, they
+// don't follow the specification and aren't linted.
+func Generated(raw string) bool {
+	for _, prefix := range []string{"Merge ", "Revert \"", "fixup! ", "squash! ", "amend! ", "Initial commit"} {
+		if strings.HasPrefix(raw, prefix) {
+			return true
+		}
+	}
+	return false
+}
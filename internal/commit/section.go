@@ -0,0 +1,96 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package commit
+
+import "strings"
+
+// Section is a group of commit types rendered under the same title.
+type Section struct {
+	Title string
+	Types []string
+}
+
+// Classifier assigns commits to changelog sections.
+type Classifier struct {
+	// Breaking is the title of the section listing breaking changes,
+	// they're listed there whatever their type is.
+	Breaking string
+
+	// Sections are the sections in display order.
+	Sections []Section
+
+	// Other is the title of the section collecting the commits no section
+	// claims, including non conventional ones. Empty hides them.
+	Other string
+
+	// Hidden lists the noise types (chore, ci, ...) left out of the changelog,
+	// breaking changes are never hidden.
+	Hidden []string
+}
+
+const (
+	SectionBreaking    = "Breaking Changes"
+	SectionFeatures    = "Features"
+	SectionBugFixes    = "Bug Fixes"
+	SectionPerformance = "Performance"
+	SectionOther       = "Other"
+)
+
+// DefaultClassifier returns the sections used when the configuration has none.
+func DefaultClassifier() *Classifier {
+	return &Classifier{
+		Breaking: SectionBreaking,
+		Sections: []Section{
+			{Title: SectionFeatures, Types: []string{"feat"}},
+			{Title: SectionBugFixes, Types: []string{"fix"}},
+			{Title: SectionPerformance, Types: []string{"perf"}},
+		},
+		Other:  SectionOther,
+		Hidden: []string{"chore", "ci", "build", "style", "test"},
+	}
+}
+
+// Classify returns the title of the section m belongs to,
+// false when the commit is hidden.
+func (c *Classifier) Classify(m Message) (string, bool) {
+	if m.Breaking && len(c.Breaking) != 0 {
+		return c.Breaking, true
+	}
+	for _, s := range c.Sections {
+		for _, t := range s.Types {
+			if strings.EqualFold(t, m.Type) {
+				return s.Title, true
+			}
+		}
+	}
+	for _, t := range c.Hidden {
+		if strings.EqualFold(t, m.Type) {
+			return "", false
+		}
+	}
+	if len(c.Other) == 0 {
+		return "", false
+	}
+	return c.Other, true
+}
+
+// Titles returns the section titles in display order, several sections
+// may share a title to merge their types.
+func (c *Classifier) Titles() []string {
+	var titles []string
+	seen := make(map[string]struct{})
+	add := func(title string) {
+		if _, ok := seen[title]; ok || len(title) == 0 {
+			return
+		}
+		seen[title] = struct{}{}
+		titles = append(titles, title)
+	}
+	add(c.Breaking)
+	for _, s := range c.Sections {
+		add(s.Title)
+	}
+	add(c.Other)
+	return titles
+}
@@ -0,0 +1,460 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package emoji
+
+import (
+	"docwiz/internal/commit"
+	_ "embed"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// gitmojis is the catalog of https://gitmoji.dev, refresh it from
+// https://gitmoji.dev/api/gitmojis.
+//
+//go:embed gitmojis.json
+var gitmojis []byte
+
+// Gitmoji is an emoji of the gitmoji specification.
+type Gitmoji struct {
+	Emoji       string `json:"emoji"`
+	Code        string `json:"code"`
+	Description string `json:"description"`
+	Name        string `json:"name"`
+
+	// Semver is the version bump the emoji calls for:
+	// major, minor, patch or empty.
+	Semver string `json:"semver"`
+}
+
+// Keyword decorates the messages containing a word.
+type Keyword struct {
+	// Word is matched as a whole word, its plural and its -ed and -ing
+	// forms. A trailing "*" matches any word starting with it, e.g. "translat*".
+	Word string
+
+	// Emoji is an emoji or a gitmoji code like ":bug:".
+	Emoji string
+
+	// Priority orders the matches, the highest first.
+	Priority int
+}
+
+// PriorityUser is the priority of the keywords registered by the users,
+// they win over the built-in ones.
+const PriorityUser = 100
+
+// Registry maps the commit types and the keywords of the messages to
+// emojis, and the emojis back to commit types.
+type Registry struct {
+	gitmojis []Gitmoji
+	// index maps the normalized emojis and the codes to their gitmoji.
+	index    map[string]int
+	types    map[string]string
+	keywords []Keyword
+}
+
+var (
+	defaultOnce     sync.Once
+	defaultRegistry *Registry
+)
+
+// Default returns the registry shared by the commands and the templates.
+func Default() *Registry {
+	defaultOnce.Do(func() {
+		defaultRegistry = New()
+	})
+	return defaultRegistry
+}
+
+// New returns a registry with the gitmoji catalog, the default types
+// and the built-in keywords.
+func New() *Registry {
+	var catalog struct {
+		Gitmojis []Gitmoji `json:"gitmojis"`
+	}
+	if err := json.Unmarshal(gitmojis, &catalog); err != nil {
+		panic(err)
+	}
+
+	r := &Registry{gitmojis: catalog.Gitmojis, index: make(map[string]int), types: make(map[string]string)}
+	for i, g := range r.gitmojis {
+		r.index[normalize(g.Emoji)] = i
+		r.index[g.Code] = i
+	}
+	for _, t := range defaultTypes {
+		r.SetType(t[0], t[1])
+	}
+	for _, k := range defaultKeywords {
+		r.AddKeyword(k.Word, k.Emoji, k.Priority)
+	}
+	return r
+}
+
+// defaultTypes maps the conventional types to their gitmoji, the first
+// type of an emoji is the one its commits are grouped under.
+var defaultTypes = [][2]string{
+	{"feat", ":sparkles:"},
+	{"fix", ":bug:"},
+	{"docs", ":memo:"},
+	{"style", ":art:"},
+	{"refactor", ":recycle:"},
+	{"perf", ":zap:"},
+	{"test", ":white_check_mark:"},
+	{"build", ":package:"},
+	{"ci", ":construction_worker:"},
+	{"chore", ":wrench:"},
+	{"revert", ":rewind:"},
+	{"deps", ":arrow_up:"},
+	{"security", ":lock:"},
+	{"i18n", ":globe_with_meridians:"},
+	{"release", ":bookmark:"},
+	{"wip", ":construction:"},
+	{"init", ":tada:"},
+}
+
+// typeAliases groups the gitmojis no type maps to.
+var typeAliases = map[string]string{
+	":ambulance:":        "fix",
+	":adhesive_bandage:": "fix",
+	":pencil2:":          "fix",
+	":green_heart:":      "ci",
+	":hammer:":           "build",
+	":arrow_down:":       "deps",
+	":pushpin:":          "deps",
+	":heavy_plus_sign:":  "deps",
+	":heavy_minus_sign:": "deps",
+	":test_tube:":        "test",
+	":camera_flash:":     "test",
+	":clown_face:":       "test",
+	":fire:":             "refactor",
+	":coffin:":           "refactor",
+	":truck:":            "refactor",
+	":rotating_light:":   "style",
+	":bulb:":             "docs",
+}
+
+var defaultKeywords = []Keyword{
+	{"breaking", ":boom:", 30},
+	{"security", ":lock:", 30},
+	{"secure", ":lock:", 30},
+	{"vuln*", ":lock:", 30},
+	{"hotfix", ":ambulance:", 25},
+	{"urgent", ":ambulance:", 25},
+	{"critical", ":ambulance:", 25},
+	{"emergency", ":ambulance:", 25},
+	{"typo", ":pencil2:", 22},
+	{"fix", ":bug:", 20},
+	{"bug", ":bug:", 20},
+	{"issue", ":bug:", 18},
+	{"problem", ":bug:", 18},
+	{"crash", ":bug:", 18},
+	{"revert", ":rewind:", 20},
+	{"merge", ":twisted_rightwards_arrows:", 20},
+	{"init", ":tada:", 20},
+	{"initial", ":tada:", 20},
+	{"release", ":rocket:", 15},
+	{"deploy", ":rocket:", 15},
+	{"launch", ":rocket:", 15},
+	{"version", ":bookmark:", 15},
+	{"tag", ":bookmark:", 15},
+	{"feat", ":sparkles:", 15},
+	{"feature", ":sparkles:", 15},
+	{"perf", ":zap:", 15},
+	{"performance", ":zap:", 15},
+	{"optimiz*", ":zap:", 15},
+	{"speed", ":zap:", 15},
+	{"downgrade", ":arrow_down:", 15},
+	{"upgrade", ":arrow_up:", 14},
+	{"bump", ":arrow_up:", 14},
+	{"dependen*", ":arrow_up:", 14},
+	{"deps", ":arrow_up:", 14},
+	{"pin", ":pushpin:", 12},
+	{"package", ":package:", 12},
+	{"license", ":page_facing_up:", 14},
+	{"gitignore", ":see_no_evil:", 14},
+	{"deprecat*", ":wastebasket:", 14},
+	{"deadcode", ":coffin:", 14},
+	{"dead", ":coffin:", 14},
+	{"unused", ":coffin:", 14},
+	{"legacy", ":coffin:", 14},
+	{"remove", ":fire:", 12},
+	{"delete", ":fire:", 12},
+	{"cleanup", ":fire:", 12},
+	{"refactor", ":recycle:", 12},
+	{"rewrite", ":recycle:", 12},
+	{"rework", ":recycle:", 12},
+	{"clean", ":recycle:", 10},
+	{"restructure", ":building_construction:", 12},
+	{"architecture", ":building_construction:", 12},
+	{"migrat*", ":building_construction:", 12},
+	{"move", ":truck:", 12},
+	{"rename", ":truck:", 12},
+	{"test", ":white_check_mark:", 12},
+	{"unittest", ":white_check_mark:", 12},
+	{"config", ":wrench:", 12},
+	{"configur*", ":wrench:", 12},
+	{"settings", ":wrench:", 12},
+	{"env", ":wrench:", 12},
+	{"ci", ":green_heart:", 12},
+	{"build", ":hammer:", 10},
+	{"script", ":hammer:", 10},
+	{"develop", ":hammer:", 10},
+	{"maintain", ":hammer:", 10},
+	{"maintenance", ":hammer:", 10},
+	{"wip", ":construction:", 12},
+	{"progress", ":construction:", 12},
+	{"i18n", ":globe_with_meridians:", 12},
+	{"translat*", ":globe_with_meridians:", 12},
+	{"locali*", ":globe_with_meridians:", 12},
+	{"accessib*", ":wheelchair:", 12},
+	{"a11y", ":wheelchair:", 12},
+	{"lint", ":rotating_light:", 12},
+	{"warning", ":rotating_light:", 12},
+	{"format", ":art:", 10},
+	{"reformat", ":art:", 10},
+	{"docs", ":memo:", 10},
+	{"doc", ":memo:", 10},
+	{"document*", ":memo:", 10},
+	{"readme", ":memo:", 10},
+	{"comment", ":bulb:", 10},
+	{"note", ":bulb:", 10},
+	{"ui", ":lipstick:", 10},
+	{"ux", ":children_crossing:", 10},
+	{"usability", ":children_crossing:", 10},
+	{"design", ":lipstick:", 10},
+	{"theme", ":lipstick:", 10},
+	{"styl*", ":lipstick:", 10},
+	{"css", ":lipstick:", 10},
+	{"responsive", ":iphone:", 10},
+	{"animation", ":dizzy:", 10},
+	{"log", ":loud_sound:", 10},
+	{"logger", ":loud_sound:", 10},
+	{"logging", ":loud_sound:", 10},
+	{"debug", ":loud_sound:", 10},
+	{"mock", ":clown_face:", 10},
+	{"fake", ":clown_face:", 10},
+	{"snapshot", ":camera_flash:", 10},
+	{"experiment*", ":alembic:", 10},
+	{"seo", ":mag:", 10},
+	{"analytics", ":chart_with_upwards_trend:", 10},
+	{"contributor", ":busts_in_silhouette:", 10},
+	{"asset", ":bento:", 10},
+	{"authoriz*", ":passport_control:", 10},
+	{"permission", ":passport_control:", 10},
+	{"validat*", ":safety_vest:", 10},
+	{"concurren*", ":thread:", 10},
+	{"thread", ":thread:", 10},
+	{"healthcheck", ":stethoscope:", 10},
+	{"infra*", ":bricks:", 10},
+	{"database", ":card_file_box:", 10},
+	{"sql", ":card_file_box:", 10},
+	{"offline", ":airplane:", 10},
+	{"flag", ":triangular_flag_on_post:", 10},
+	{"seed", ":seedling:", 10},
+	{"secret", ":closed_lock_with_key:", 10},
+	{"add", ":sparkles:", 8},
+	{"new", ":sparkles:", 8},
+	{"introduce", ":sparkles:", 8},
+	{"implement", ":sparkles:", 8},
+	{"improve", ":sparkles:", 8},
+	{"update", ":memo:", 5},
+	{"error", ":goal_net:", 5},
+}
+
+// Gitmojis returns the gitmoji catalog.
+func (r *Registry) Gitmojis() []Gitmoji {
+	return r.gitmojis
+}
+
+// Lookup returns the gitmoji of an emoji or a code like ":bug:".
+func (r *Registry) Lookup(s string) (Gitmoji, bool) {
+	i, ok := r.index[normalize(s)]
+	if !ok {
+		return Gitmoji{}, false
+	}
+	return r.gitmojis[i], true
+}
+
+// Resolve returns the emoji of a gitmoji code, other values are returned as is.
+func (r *Registry) Resolve(s string) string {
+	if g, ok := r.Lookup(s); ok && strings.HasPrefix(s, ":") {
+		return g.Emoji
+	}
+	return s
+}
+
+// SetType maps a commit type to an emoji or a gitmoji code.
+func (r *Registry) SetType(typ, emoji string) {
+	r.types[strings.ToLower(typ)] = r.Resolve(emoji)
+}
+
+// ForType returns the emoji of a commit type.
+func (r *Registry) ForType(typ string) (string, bool) {
+	emoji, ok := r.types[strings.ToLower(typ)]
+	return emoji, ok
+}
+
+// TypeOf returns the commit type of an emoji or a gitmoji code: the
+// first type mapped to it, or the type its semver impact calls for
+// (feat for minor, fix for patch).
+func (r *Registry) TypeOf(emoji string) (string, bool) {
+	emoji = normalize(r.Resolve(emoji))
+	for _, t := range defaultTypes {
+		if e, ok := r.types[t[0]]; ok && normalize(e) == emoji {
+			return t[0], true
+		}
+	}
+	var types []string
+	for t, e := range r.types {
+		if normalize(e) == emoji {
+			types = append(types, t)
+		}
+	}
+	if len(types) != 0 {
+		sort.Strings(types)
+		return types[0], true
+	}
+
+	g, ok := r.Lookup(emoji)
+	if !ok {
+		return "", false
+	}
+	if t, ok := typeAliases[g.Code]; ok {
+		return t, true
+	}
+	switch g.Semver {
+	case "minor":
+		return "feat", true
+	case "patch":
+		return "fix", true
+	}
+	return "", false
+}
+
+// Breaking reports the emojis introducing breaking changes, like 💥.
+func (r *Registry) Breaking(emoji string) bool {
+	g, ok := r.Lookup(emoji)
+	return ok && g.Semver == "major"
+}
+
+// AddKeyword registers a keyword, see Keyword.
+func (r *Registry) AddKeyword(word, emoji string, priority int) {
+	k := Keyword{Word: strings.ToLower(word), Emoji: r.Resolve(emoji), Priority: priority}
+	// keep the keywords sorted by priority, after the ones registered
+	// before with the same priority
+	i := sort.Search(len(r.keywords), func(i int) bool {
+		return r.keywords[i].Priority < k.Priority
+	})
+	r.keywords = append(r.keywords[:i], append([]Keyword{k}, r.keywords[i:]...)...)
+}
+
+// Match returns at most max emojis of the keywords found in text, the
+// highest priority first, then in the order of the words.
+func (r *Registry) Match(text string, max int) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+	})
+
+	type match struct {
+		emoji    string
+		priority int
+		position int
+	}
+	var matches []match
+	for pos, word := range words {
+		for _, k := range r.keywords {
+			if k.matches(word) {
+				matches = append(matches, match{k.Emoji, k.Priority, pos})
+				break
+			}
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].priority != matches[j].priority {
+			return matches[i].priority > matches[j].priority
+		}
+		return matches[i].position < matches[j].position
+	})
+
+	var emojis []string
+	for _, m := range matches {
+		if len(emojis) >= max {
+			break
+		}
+		if !containsEmoji(emojis, m.emoji) {
+			emojis = append(emojis, m.emoji)
+		}
+	}
+	return emojis
+}
+
+// Emojis returns at most max emojis describing a commit message: the
+// emoji of its conventional type followed by 💥 for breaking changes or,
+// for the other messages, the emojis of its keywords.
+func (r *Registry) Emojis(message string, max int) []string {
+	m := commit.Parse(message)
+	if m.Conventional() {
+		var emojis []string
+		if emoji, ok := r.ForType(m.Type); ok {
+			emojis = append(emojis, emoji)
+		}
+		if m.Breaking && len(emojis) < max {
+			if boom := r.Resolve(":boom:"); !containsEmoji(emojis, boom) {
+				emojis = append(emojis, boom)
+			}
+		}
+		if len(emojis) != 0 {
+			return emojis
+		}
+	}
+	return r.Match(m.Subject, max)
+}
+
+// Decorate prefixes the message with at most max emojis, see Emojis.
+func (r *Registry) Decorate(message string, max int) string {
+	emojis := r.Emojis(message, max)
+	if len(emojis) == 0 {
+		return message
+	}
+	return strings.Join(emojis, " ") + " " + message
+}
+
+func (k Keyword) matches(word string) bool {
+	if stem, ok := strings.CutSuffix(k.Word, "*"); ok {
+		return strings.HasPrefix(word, stem)
+	}
+	if word == k.Word {
+		return true
+	}
+	rest, ok := strings.CutPrefix(word, k.Word)
+	if !ok {
+		// remove → removing
+		rest, ok = strings.CutPrefix(word, strings.TrimSuffix(k.Word, "e"))
+		return ok && rest == "ing"
+	}
+	switch rest {
+	case "s", "es", "d", "ed", "ing":
+		return true
+	}
+	// stop → stopped, stopping
+	return len(rest) > 2 && rest[0] == k.Word[len(k.Word)-1] && (rest[1:] == "ed" || rest[1:] == "ing")
+}
+
+// normalize removes the variation selectors, "⚡️" and "⚡" are the same emoji.
+func normalize(emoji string) string {
+	return strings.ReplaceAll(emoji, "\uFE0F", "")
+}
+
+func containsEmoji(emojis []string, emoji string) bool {
+	for _, e := range emojis {
+		if normalize(e) == normalize(emoji) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package emoji
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalog(t *testing.T) {
+	r := New()
+	assert.GreaterOrEqual(t, len(r.Gitmojis()), 70)
+
+	g, ok := r.Lookup(":zap:")
+	assert.True(t, ok)
+	assert.Equal(t, "patch", g.Semver)
+	// with or without the variation selector
+	_, ok = r.Lookup("⚡")
+	assert.True(t, ok)
+	assert.Equal(t, "✨", r.Resolve(":sparkles:"))
+	assert.Equal(t, ":unknown:", r.Resolve(":unknown:"))
+}
+
+func TestTypeOf(t *testing.T) {
+	r := New()
+	for emoji, typ := range map[string]string{
+		"✨":                     "feat",
+		":bug:":                 "fix",
+		"🚑️":                    "fix",
+		"⚡":                     "perf",
+		"💚":                     "ci",
+		"💄":                     "fix",
+		":arrow_up:":            "deps",
+		":busts_in_silhouette:": "",
+	} {
+		got, _ := r.TypeOf(emoji)
+		assert.Equal(t, typ, got, emoji)
+	}
+	assert.True(t, r.Breaking("💥"))
+	assert.False(t, r.Breaking("✨"))
+
+	r.SetType("feature", ":sparkles:")
+	got, _ := r.TypeOf("✨")
+	assert.Equal(t, "feat", got)
+}
+
+func TestMatch(t *testing.T) {
+	r := New()
+	assert.Equal(t, []string{"🔒️", "🐛"}, r.Match("fixed the security hole", 2))
+	assert.Equal(t, []string{"🔥"}, r.Match("Removing the old parser", 2))
+	assert.Equal(t, []string{"🌐"}, r.Match("translations", 2))
+	assert.Empty(t, r.Match("circle docker", 2))
+
+	r.AddKeyword("parser", ":label:", PriorityUser)
+	assert.Equal(t, []string{"🏷️", "🔥"}, r.Match("remove the parser", 2))
+}
+
+func TestDecorate(t *testing.T) {
+	r := New()
+	assert.Equal(t, "♻️ refactor(api): rename handlers", r.Decorate("refactor(api): rename handlers", 2))
+	assert.Equal(t, "💥 unknown!: x", r.Decorate("unknown!: x", 2))
+	assert.Equal(t, "🚚 rename handlers", r.Decorate("rename handlers", 2))
+
+	r.SetType("deps", "⬇️")
+	assert.Equal(t, "⬇️ deps: downgrade x", r.Decorate("deps: downgrade x", 2))
+}
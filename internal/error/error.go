@@ -1,4 +1,197 @@
 // Copyright 2025 The DocWiz Authors. All rights reserved.
 // Use of this source code is governed by a MIT-style
 // license that can be found in the LICENSE file.
+
+// Package error defines the errors of the commands, their category
+// decides the exit code of docwiz:
+//
+//	0    success
+//	1    internal error (a bug of docwiz) or failed check, e.g. "commit lint"
+//	2    user input: invalid flags, arguments, configuration or data files
+//	3    template: the template is missing or fails to render
+//	4    git: the repository or its history can't be read
+//	5    IO: a file can't be read or written
+//	130  canceled by the user, e.g. a form left without saving
 package error
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// Kind is the category of an error.
+type Kind int
+
+const (
+	KindInternal Kind = iota
+	KindUser
+	KindTemplate
+	KindGit
+	KindIO
+	KindCanceled
+	KindCheck
+)
+
+// exitCodes are the exit codes of the kinds.
+var exitCodes = map[Kind]int{
+	KindInternal: 1,
+	KindUser:     2,
+	KindTemplate: 3,
+	KindGit:      4,
+	KindIO:       5,
+	KindCanceled: 130,
+	KindCheck:    1,
+}
+
+func (k Kind) String() string {
+	switch k {
+	case KindUser:
+		return "user input"
+	case KindTemplate:
+		return "template"
+	case KindGit:
+		return "git"
+	case KindIO:
+		return "io"
+	case KindCanceled:
+		return "canceled"
+	case KindCheck:
+		return "check"
+	}
+	return "internal"
+}
+
+// ExitCode returns the exit code of the kind.
+func (k Kind) ExitCode() int {
+	return exitCodes[k]
+}
+
+// Error is a failure of a command. Msg tells what docwiz was doing, e.g.
+// "fail to read git repository", and Err is the cause, when there's one.
+type Error struct {
+	Kind Kind
+	Msg  string
+	Err  error
+
+	// Fields are the context printed with the error, e.g. the path of a file.
+	Fields map[string]any
+
+	stack []uintptr
+}
+
+func newError(kind Kind, err error, format string, args ...any) *Error {
+	e := &Error{Kind: kind, Msg: fmt.Sprintf(format, args...), Err: err}
+	var pcs [32]uintptr
+	n := runtime.Callers(3, pcs[:])
+	e.stack = pcs[:n]
+	return e
+}
+
+// User returns an error of the flags, the arguments, the configuration
+// or the data files given by the user, err may be nil.
+func User(err error, format string, args ...any) *Error {
+	return newError(KindUser, err, format, args...)
+}
+
+// Template returns an error loading or executing a template.
+func Template(err error, format string, args ...any) *Error {
+	return newError(KindTemplate, err, format, args...)
+}
+
+// Git returns an error reading the repository.
+func Git(err error, format string, args ...any) *Error {
+	return newError(KindGit, err, format, args...)
+}
+
+// IO returns an error reading or writing a file.
+func IO(err error, format string, args ...any) *Error {
+	return newError(KindIO, err, format, args...)
+}
+
+// Internal returns an error which isn't the fault of the user, e.g. a
+// form which can't run without a terminal.
+func Internal(err error, format string, args ...any) *Error {
+	return newError(KindInternal, err, format, args...)
+}
+
+// Check returns the error of a check which failed, e.g. a commit message
+// breaking the rules.
+func Check(format string, args ...any) *Error {
+	return newError(KindCheck, nil, format, args...)
+}
+
+// Canceled returns the error of an operation stopped by the user.
+func Canceled(format string, args ...any) *Error {
+	return newError(KindCanceled, nil, format, args...)
+}
+
+// WithField adds a field to the context of the error.
+func (e *Error) WithField(key string, value any) *Error {
+	if e.Fields == nil {
+		e.Fields = make(map[string]any)
+	}
+	e.Fields[key] = value
+	return e
+}
+
+func (e *Error) Error() string {
+	if e.Err == nil {
+		return e.Msg
+	}
+	return e.Msg + ": " + e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Stack returns the calls which created the error, one function and its
+// location per frame.
+func (e *Error) Stack() string {
+	var sb strings.Builder
+	frames := runtime.CallersFrames(e.stack)
+	for {
+		frame, more := frames.Next()
+		if len(frame.Function) != 0 {
+			fmt.Fprintf(&sb, "%s\n  %s:%d\n", frame.Function, frame.File, frame.Line)
+		}
+		if !more {
+			break
+		}
+	}
+	return sb.String()
+}
+
+// KindOf returns the kind of the first Error of the chain, or internal.
+func KindOf(err error) Kind {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Kind
+	}
+	return KindInternal
+}
+
+// ExitCode returns the exit code of err, 0 for nil.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	return KindOf(err).ExitCode()
+}
+
+// FromPanic converts a recovered value to an internal error, its stack
+// is the one of the panic.
+func FromPanic(v any) *Error {
+	err, ok := v.(error)
+	if !ok {
+		err = fmt.Errorf("%v", v)
+	}
+	e := &Error{Kind: KindInternal, Msg: "unexpected error, please report it", Err: err}
+	var pcs [32]uintptr
+	// skip runtime.Callers, FromPanic, the deferred function and runtime.gopanic
+	n := runtime.Callers(4, pcs[:])
+	e.stack = pcs[:n]
+	return e
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package error
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExitCode(t *testing.T) {
+	assert.Equal(t, 0, ExitCode(nil))
+	assert.Equal(t, 1, ExitCode(errors.New("boom")))
+	assert.Equal(t, 2, ExitCode(User(nil, "invalid flag")))
+	assert.Equal(t, 3, ExitCode(Template(nil, "fail to load template")))
+	assert.Equal(t, 4, ExitCode(Git(nil, "fail to read git repository")))
+	assert.Equal(t, 5, ExitCode(IO(fs.ErrExist, "fail to create file")))
+	assert.Equal(t, 130, ExitCode(Canceled("aborted")))
+	assert.Equal(t, 1, ExitCode(Check("%d commits break the rules", 2)))
+
+	// the kind of the first Error of the chain wins
+	wrapped := fmt.Errorf("generating: %w", Template(IO(nil, "reading"), "loading"))
+	assert.Equal(t, KindTemplate, KindOf(wrapped))
+	assert.Equal(t, 3, ExitCode(wrapped))
+}
+
+func TestError(t *testing.T) {
+	err := IO(fs.ErrExist, "writing %s", "README.md").WithField("path", "README.md")
+	assert.Equal(t, "writing README.md: file already exists", err.Error())
+	assert.ErrorIs(t, err, fs.ErrExist)
+	assert.Equal(t, "README.md", err.Fields["path"])
+	assert.Contains(t, err.Stack(), "TestError")
+
+	assert.Equal(t, "aborted", Canceled("aborted").Error())
+}
+
+func TestFromPanic(t *testing.T) {
+	catch := func(f func()) (err *Error) {
+		defer func() {
+			err = FromPanic(recover())
+		}()
+		f()
+		return nil
+	}
+
+	err := catch(func() { panic("WIP") })
+	assert.Equal(t, KindInternal, err.Kind)
+	assert.Equal(t, "unexpected error, please report it: WIP", err.Error())
+	assert.Contains(t, err.Stack(), "TestFromPanic")
+
+	err = catch(func() { panic(fs.ErrNotExist) })
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
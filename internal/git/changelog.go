@@ -0,0 +1,252 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package git
+
+import (
+	"docwiz/internal/commit"
+	"docwiz/internal/emoji"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// ChangelogOptions customizes the changelog generation.
+type ChangelogOptions struct {
+	// Classifier groups the commits into sections,
+	// commit.DefaultClassifier is used when it's nil.
+	Classifier *commit.Classifier
+
+	// Tags selects the tags delimiting the releases.
+	Tags TagOptions
+
+	// FirstParent only follows the first parent of merge commits, merged
+	// branches are summarized by their merge commit (e.g. a pull request).
+	FirstParent bool
+}
+
+// Changelog is the model rendered by the templates of template/CHANGELOG.
+type Changelog struct {
+	Owner string
+	Name  string
+	// URL is the web page of the repository, e.g. "https://github.com/owner/name".
+	URL      string
+	Releases []Release
+}
+
+// Release is a tagged version of the project and the commits it introduced.
+type Release struct {
+	// Name is the tag name, it's empty for the unreleased commits.
+	Name string
+
+	// Unreleased reports the commits made since the latest tag.
+	Unreleased bool
+
+	// Date is the date of the tagged commit.
+	Date time.Time
+
+	// Previous is the name of the previous release, empty for the first one.
+	Previous string
+
+	// URL links to the tree of the release.
+	URL string
+
+	// CompareURL links to the diff with the previous release.
+	CompareURL string
+
+	Sections []ReleaseSection
+
+	// Authors lists the authors of the release's commits in order of appearance.
+	Authors []string
+}
+
+// ReleaseSection is a group of commits of a release, e.g. "Features".
+type ReleaseSection struct {
+	Title   string
+	Commits []ChangelogCommit
+}
+
+// ChangelogCommit is a commit as displayed in the changelog.
+type ChangelogCommit struct {
+	Hash      string
+	ShortHash string
+	URL       string
+
+	Type  string
+	Scope string
+
+	// Subject is the description of the commit, references to issues
+	// and users are turned into markdown links.
+	Subject string
+
+	Breaking     bool
+	BreakingNote string
+
+	Author string
+	Date   time.Time
+
+	Message commit.Message
+}
+
+// Changelog returns the releases from the latest to the oldest,
+// with their commits grouped by section.
+func (r *Repository) Changelog(opts ChangelogOptions) (*Changelog, error) {
+	classifier := opts.Classifier
+	if classifier == nil {
+		classifier = commit.DefaultClassifier()
+	}
+
+	changelog := &Changelog{Owner: r.owner, Name: r.name, URL: r.forge.RepoURL()}
+	tags, err := r.Tags(opts.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := r.repo.Head()
+	if err != nil {
+		return nil, err
+	}
+	head, err := r.repo.CommitObject(ref.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	// commits that aren't part of any tag are unreleased
+	h := newHistory(opts.FirstParent)
+	released := make(map[plumbing.Hash]struct{})
+	for _, tag := range tags {
+		ancestors, err := h.ancestors(tag.Commit)
+		if err != nil {
+			return nil, err
+		}
+		for hash := range ancestors {
+			released[hash] = struct{}{}
+		}
+	}
+	unreleased, err := h.between(head, released)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := ""
+	if len(tags) != 0 {
+		latest = tags[0].Name
+	}
+	if release := r.newRelease(TagInfo{}, latest, unreleased, classifier); len(release.Sections) != 0 {
+		release.Unreleased = true
+		changelog.Releases = append(changelog.Releases, release)
+	}
+
+	// a release is made of the commits reachable from its tag
+	// but not from the tag of the previous version
+	for i, tag := range tags {
+		previous := ""
+		exclude := map[plumbing.Hash]struct{}{}
+		if i+1 < len(tags) {
+			previous = tags[i+1].Name
+			if exclude, err = h.ancestors(tags[i+1].Commit); err != nil {
+				return nil, err
+			}
+		}
+		commits, err := h.between(tag.Commit, exclude)
+		if err != nil {
+			return nil, err
+		}
+		changelog.Releases = append(changelog.Releases, r.newRelease(tag, previous, commits, classifier))
+	}
+	return changelog, nil
+}
+
+// newRelease groups the commits into the sections of the classifier,
+// hidden commits are dropped and empty sections are omitted.
+func (r *Repository) newRelease(tag TagInfo, previous string, commits []*object.Commit, classifier *commit.Classifier) Release {
+	release := Release{Name: tag.Name, Previous: previous}
+	if tag.Commit != nil {
+		release.Date = tag.Commit.Committer.When
+	}
+	head := tag.Name
+	if len(head) == 0 {
+		head = "HEAD"
+		branch := r.Branch()
+		if len(branch) == 0 {
+			branch = head
+		}
+		release.URL = r.forge.TreeURL(branch)
+	} else {
+		release.URL = r.forge.TagURL(head)
+	}
+	if len(previous) != 0 {
+		release.CompareURL = r.forge.CompareURL(previous, head)
+	}
+
+	grouped := make(map[string][]ChangelogCommit)
+	authors := make(map[string]struct{})
+	for _, c := range commits {
+		if _, ok := authors[c.Author.Name]; !ok {
+			authors[c.Author.Name] = struct{}{}
+			release.Authors = append(release.Authors, c.Author.Name)
+		}
+
+		msg := parseMessage(summarizeMerge(c))
+		title, ok := classifier.Classify(msg)
+		if !ok {
+			continue
+		}
+		grouped[title] = append(grouped[title], r.newChangelogCommit(c, msg))
+	}
+
+	for _, title := range classifier.Titles() {
+		if commits, ok := grouped[title]; ok {
+			release.Sections = append(release.Sections, ReleaseSection{Title: title, Commits: commits})
+		}
+	}
+	return release
+}
+
+// NameRelease returns the unreleased commits as the release of the tag name,
+// typically the next version computed before the tag is created.
+func (r *Repository) NameRelease(release Release, name string) Release {
+	release.Name = name
+	release.Unreleased = false
+	release.Date = time.Now()
+	release.URL = r.forge.TagURL(name)
+	if len(release.Previous) != 0 {
+		release.CompareURL = r.forge.CompareURL(release.Previous, name)
+	}
+	return release
+}
+
+// parseMessage parses a commit message, the type of gitmoji commits
+// like "✨ add parser" is deduced from their emoji.
+func parseMessage(raw string) commit.Message {
+	m := commit.Parse(raw)
+	if m.Conventional() || len(m.Emoji) == 0 {
+		return m
+	}
+	registry := emoji.Default()
+	if t, ok := registry.TypeOf(m.Emoji); ok {
+		m.Type = t
+	}
+	if registry.Breaking(m.Emoji) {
+		m.Breaking = true
+	}
+	return m
+}
+
+func (r *Repository) newChangelogCommit(c *object.Commit, msg commit.Message) ChangelogCommit {
+	hash := c.Hash.String()
+	return ChangelogCommit{
+		Hash:         hash,
+		ShortHash:    hash[:7],
+		URL:          r.forge.CommitURL(hash),
+		Type:         msg.Type,
+		Scope:        msg.Scope,
+		Subject:      r.formatCommitMessage(msg.Subject),
+		Breaking:     msg.Breaking,
+		BreakingNote: r.formatCommitMessage(msg.BreakingNote),
+		Author:       c.Author.Name,
+		Date:         c.Author.When,
+		Message:      msg,
+	}
+}
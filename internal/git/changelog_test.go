@@ -0,0 +1,382 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package git_test
+
+import (
+	"docwiz/internal/commit"
+	"docwiz/internal/git"
+	"testing"
+	"time"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+)
+
+// testRepo builds repositories commit by commit, each commit is one
+// hour after the previous one so that tags sort deterministically.
+type testRepo struct {
+	t    *testing.T
+	dir  string
+	repo *gogit.Repository
+	when time.Time
+}
+
+func newTestRepo(t *testing.T) *testRepo {
+	dir := t.TempDir()
+	repo, err := gogit.PlainInit(dir, false)
+	assert.NoError(t, err)
+	_, err = repo.CreateRemote(&config.RemoteConfig{Name: "origin", URLs: []string{"git@github.com:acme/widget.git"}})
+	assert.NoError(t, err)
+	return &testRepo{t: t, dir: dir, repo: repo, when: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (r *testRepo) commit(message string) {
+	r.commitAs("Alice", "alice@example.com", message)
+}
+
+func (r *testRepo) commitAs(name, email, message string) {
+	wt, err := r.repo.Worktree()
+	assert.NoError(r.t, err)
+	r.when = r.when.Add(time.Hour)
+	sig := &object.Signature{Name: name, Email: email, When: r.when}
+	_, err = wt.Commit(message, &gogit.CommitOptions{AllowEmptyCommits: true, Author: sig, Committer: sig})
+	assert.NoError(r.t, err)
+}
+
+func (r *testRepo) tag(name string) {
+	head, err := r.repo.Head()
+	assert.NoError(r.t, err)
+	_, err = r.repo.CreateTag(name, head.Hash(), nil)
+	assert.NoError(r.t, err)
+}
+
+func (r *testRepo) checkout(branch string, create bool) {
+	wt, err := r.repo.Worktree()
+	assert.NoError(r.t, err)
+	err = wt.Checkout(&gogit.CheckoutOptions{Branch: plumbing.NewBranchReferenceName(branch), Create: create})
+	assert.NoError(r.t, err)
+}
+
+// merge commits the merge of branch into the checked out branch.
+func (r *testRepo) merge(branch, message string) {
+	head, err := r.repo.Head()
+	assert.NoError(r.t, err)
+	other, err := r.repo.Reference(plumbing.NewBranchReferenceName(branch), true)
+	assert.NoError(r.t, err)
+	wt, err := r.repo.Worktree()
+	assert.NoError(r.t, err)
+	r.when = r.when.Add(time.Hour)
+	sig := &object.Signature{Name: "Alice", Email: "alice@example.com", When: r.when}
+	_, err = wt.Commit(message, &gogit.CommitOptions{
+		AllowEmptyCommits: true,
+		Author:            sig,
+		Committer:         sig,
+		Parents:           []plumbing.Hash{head.Hash(), other.Hash()},
+	})
+	assert.NoError(r.t, err)
+}
+
+func (r *testRepo) open() *git.Repository {
+	repo, err := git.New(r.dir)
+	assert.NoError(r.t, err)
+	return repo
+}
+
+func sectionTitles(release git.Release) []string {
+	var titles []string
+	for _, s := range release.Sections {
+		titles = append(titles, s.Title)
+	}
+	return titles
+}
+
+func TestChangelog(t *testing.T) {
+	r := newTestRepo(t)
+	r.commit("init")
+	r.commit("feat: first")
+	r.tag("v0.1.0")
+	r.commitAs("Bob", "bob@example.com", "feat(parser): arrays #12")
+	r.commit("chore: deps")
+	r.commit("fix!: rename key\n\nBREAKING CHANGE: foo is now bar")
+	r.tag("v0.2.0")
+
+	changelog, err := r.open().Changelog(git.ChangelogOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://github.com/acme/widget", changelog.URL)
+	assert.Len(t, changelog.Releases, 2)
+
+	latest := changelog.Releases[0]
+	assert.Equal(t, "v0.2.0", latest.Name)
+	assert.Equal(t, "v0.1.0", latest.Previous)
+	assert.Equal(t, "https://github.com/acme/widget/compare/v0.1.0...v0.2.0", latest.CompareURL)
+	assert.Equal(t, []string{commit.SectionBreaking, commit.SectionFeatures}, sectionTitles(latest))
+	assert.Equal(t, []string{"Alice", "Bob"}, latest.Authors)
+
+	feature := latest.Sections[1].Commits[0]
+	assert.Equal(t, "parser", feature.Scope)
+	assert.Equal(t, "arrays [#12](https://github.com/acme/widget/issues/12)", feature.Subject)
+	assert.Equal(t, "foo is now bar", latest.Sections[0].Commits[0].BreakingNote)
+
+	first := changelog.Releases[1]
+	assert.Equal(t, "v0.1.0", first.Name)
+	assert.Empty(t, first.CompareURL)
+	assert.Equal(t, []string{commit.SectionFeatures, commit.SectionOther}, sectionTitles(first))
+}
+
+func TestChangelogGitmoji(t *testing.T) {
+	r := newTestRepo(t)
+	r.commit("✨ add the parser")
+	r.commit(":bug: crash on empty input")
+	r.commit("💥 drop the v1 API")
+	r.commit("🔥 remove dead code")
+
+	changelog, err := r.open().Changelog(git.ChangelogOptions{})
+	assert.NoError(t, err)
+	release := changelog.Releases[0]
+	assert.Equal(t, []string{commit.SectionBreaking, commit.SectionFeatures, commit.SectionBugFixes, commit.SectionOther}, sectionTitles(release))
+	assert.Equal(t, "drop the v1 API", release.Sections[0].Commits[0].Subject)
+	assert.Equal(t, "fix", release.Sections[2].Commits[0].Type)
+	assert.Equal(t, "refactor", release.Sections[3].Commits[0].Type)
+}
+
+func TestChangelogUnreleased(t *testing.T) {
+	r := newTestRepo(t)
+	r.commit("feat: first")
+	r.tag("v1.0.0")
+	r.commit("fix: after the release")
+	r.commit("chore: hidden")
+
+	changelog, err := r.open().Changelog(git.ChangelogOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, changelog.Releases, 2)
+
+	unreleased := changelog.Releases[0]
+	assert.True(t, unreleased.Unreleased)
+	assert.Empty(t, unreleased.Name)
+	assert.Equal(t, "v1.0.0", unreleased.Previous)
+	assert.Equal(t, "https://github.com/acme/widget/compare/v1.0.0...HEAD", unreleased.CompareURL)
+	assert.Equal(t, []string{commit.SectionBugFixes}, sectionTitles(unreleased))
+
+	// without tags every commit is unreleased
+	r = newTestRepo(t)
+	r.commit("feat: first")
+	changelog, err = r.open().Changelog(git.ChangelogOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, changelog.Releases, 1)
+	assert.True(t, changelog.Releases[0].Unreleased)
+	assert.Empty(t, changelog.Releases[0].CompareURL)
+}
+
+func releaseNames(changelog *git.Changelog) []string {
+	var names []string
+	for _, release := range changelog.Releases {
+		names = append(names, release.Name)
+	}
+	return names
+}
+
+func subjects(release git.Release) []string {
+	var subjects []string
+	for _, s := range release.Sections {
+		for _, c := range s.Commits {
+			subjects = append(subjects, c.Subject)
+		}
+	}
+	return subjects
+}
+
+func TestTags(t *testing.T) {
+	r := newTestRepo(t)
+	r.commit("init")
+	r.tag("v1.10.0")
+	r.commit("feat: a")
+	r.tag("api/v0.1.0")
+	r.commit("feat: b")
+	// tagged after v1.10.0, but an older version
+	r.tag("v1.9.0")
+	r.commit("feat: c")
+	r.tag("v1.10.1-rc.1")
+	r.tag("nightly")
+
+	repo := r.open()
+	tags, err := repo.Tags(git.TagOptions{})
+	assert.NoError(t, err)
+	var names []string
+	for _, tag := range tags {
+		names = append(names, tag.Name)
+	}
+	assert.Equal(t, []string{"v1.10.1-rc.1", "v1.10.0", "v1.9.0", "api/v0.1.0", "nightly"}, names)
+	assert.True(t, tags[0].Prerelease())
+
+	tags, err = repo.Tags(git.TagOptions{Pattern: "api/v*"})
+	assert.NoError(t, err)
+	assert.Len(t, tags, 1)
+	assert.Equal(t, "0.1.0", tags[0].Version.String())
+
+	tags, err = repo.Tags(git.TagOptions{Pattern: "v*", SkipPrereleases: true})
+	assert.NoError(t, err)
+	assert.Len(t, tags, 2)
+	assert.Equal(t, "v1.10.0", tags[0].Name)
+}
+
+func TestChangelogPrereleases(t *testing.T) {
+	r := newTestRepo(t)
+	r.commit("feat: a")
+	r.tag("v1.0.0-rc.1")
+	r.commit("fix: b")
+	r.tag("v1.0.0")
+
+	changelog, err := r.open().Changelog(git.ChangelogOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"v1.0.0", "v1.0.0-rc.1"}, releaseNames(changelog))
+	assert.Equal(t, []string{"b"}, subjects(changelog.Releases[0]))
+
+	changelog, err = r.open().Changelog(git.ChangelogOptions{Tags: git.TagOptions{SkipPrereleases: true}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"v1.0.0"}, releaseNames(changelog))
+	assert.Equal(t, []string{"a", "b"}, subjects(changelog.Releases[0]))
+}
+
+func TestChangelogMergedBranch(t *testing.T) {
+	r := newTestRepo(t)
+	r.commit("feat: a")
+	r.tag("v1.0.0")
+	r.checkout("feature", true)
+	r.commit("feat: b")
+	r.checkout("master", false)
+	r.commit("fix: c")
+	r.tag("v1.1.0")
+	r.merge("feature", "Merge pull request #7 from bob/feature\n\nfeat: add b")
+	r.tag("v1.2.0")
+
+	changelog, err := r.open().Changelog(git.ChangelogOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"v1.2.0", "v1.1.0", "v1.0.0"}, releaseNames(changelog))
+	// b was committed before c, but only merged after v1.1.0
+	assert.Equal(t, []string{"b"}, subjects(changelog.Releases[0]))
+	assert.Equal(t, []string{"c"}, subjects(changelog.Releases[1]))
+
+	changelog, err = r.open().Changelog(git.ChangelogOptions{FirstParent: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"add b ([#7](https://github.com/acme/widget/issues/7))"}, subjects(changelog.Releases[0]))
+	assert.Equal(t, "feat", changelog.Releases[0].Sections[0].Commits[0].Type)
+}
+
+func TestChangelogBackport(t *testing.T) {
+	r := newTestRepo(t)
+	r.commit("feat: a")
+	r.tag("v1.0.0")
+	r.checkout("release-1.0", true)
+	r.commit("fix: backport")
+	r.tag("v1.0.1")
+	r.checkout("master", false)
+	r.commit("feat: b")
+	r.tag("v1.1.0")
+
+	changelog, err := r.open().Changelog(git.ChangelogOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"v1.1.0", "v1.0.1", "v1.0.0"}, releaseNames(changelog))
+	assert.Equal(t, []string{"b"}, subjects(changelog.Releases[0]))
+	assert.Equal(t, []string{"backport"}, subjects(changelog.Releases[1]))
+}
+
+func TestReleaseNotes(t *testing.T) {
+	r := newTestRepo(t)
+	r.commit("feat: a")
+	r.tag("v1.0.0")
+	r.commit("feat: b")
+	r.commitAs("Bob", "bob@example.com", "fix!: c\n\nBREAKING CHANGE: d")
+	r.commit("feat(ui): e\n\nHighlight: yes")
+
+	notes, err := r.open().ReleaseNotes(git.ReleaseNotesOptions{})
+	assert.NoError(t, err)
+	assert.True(t, notes.Release.Unreleased)
+	assert.Equal(t, "v1.0.0", notes.Release.Previous)
+	assert.Equal(t, "https://github.com/acme/widget/compare/v1.0.0...HEAD", notes.Release.CompareURL)
+	assert.Len(t, notes.Highlights, 1)
+	assert.Equal(t, "e", notes.Highlights[0].Subject)
+	assert.Len(t, notes.Breaking, 1)
+	assert.Equal(t, []string{"Features"}, sectionTitles(notes.Release))
+	assert.Equal(t, []git.Contributor{
+		{Name: "Alice", Email: "alice@example.com", Commits: 2},
+		{Name: "Bob", Email: "bob@example.com", Commits: 1, FirstTime: true},
+	}, notes.Contributors)
+
+	r.tag("v1.1.0")
+	notes, err = r.open().ReleaseNotes(git.ReleaseNotesOptions{From: "HEAD~1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "v1.1.0", notes.Release.Name)
+	assert.Equal(t, []string{"e"}, subjects(notes.Release))
+}
+
+func TestNextVersion(t *testing.T) {
+	r := newTestRepo(t)
+	r.commit("feat: a")
+
+	next, err := r.open().NextVersion(git.NextVersionOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, next.Current)
+	assert.Equal(t, "v0.1.0", next.Tag)
+
+	r.tag("api/v1.2.3")
+	r.commit("docs: b")
+	next, err = r.open().NextVersion(git.NextVersionOptions{Tags: git.TagOptions{Pattern: "api/v*"}})
+	assert.NoError(t, err)
+	assert.Equal(t, commit.BumpNone, next.Bump)
+	assert.Equal(t, "api/v1.2.3", next.Tag)
+
+	r.commit("fix!: c")
+	next, err = r.open().NextVersion(git.NextVersionOptions{Pre: "rc"})
+	assert.NoError(t, err)
+	assert.Equal(t, commit.BumpMajor, next.Bump)
+	assert.Equal(t, 2, next.Commits)
+	assert.Equal(t, "api/v2.0.0-rc.1", next.Tag)
+
+	r.tag("api/v2.0.0-rc.1")
+	r.commit("fix: d")
+	next, err = r.open().NextVersion(git.NextVersionOptions{Pre: "rc"})
+	assert.NoError(t, err)
+	assert.Equal(t, "api/v2.0.0-rc.2", next.Tag)
+	next, err = r.open().NextVersion(git.NextVersionOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "api/v2.0.0", next.Tag)
+}
+
+func TestCommitRange(t *testing.T) {
+	r := newTestRepo(t)
+	r.commit("feat: a")
+	r.tag("v1.0.0")
+	r.commit("fix: b")
+	r.commit("docs: c")
+
+	commits, err := r.open().CommitRange("v1.0.0..HEAD")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"docs: c", "fix: b"}, []string{commits[0].Message, commits[1].Message})
+
+	commits, err = r.open().CommitRange("HEAD")
+	assert.NoError(t, err)
+	assert.Len(t, commits, 3)
+
+	_, err = r.open().CommitRange("v9..HEAD")
+	assert.Error(t, err)
+}
+
+func TestScopes(t *testing.T) {
+	r := newTestRepo(t)
+	r.commit("feat(parser): a")
+	r.commit("fix(cli): b")
+	r.commit("fix(parser): c")
+	r.commit("docs: d")
+
+	scopes, err := r.open().Scopes(10)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"parser", "cli"}, scopes)
+
+	scopes, err = r.open().Scopes(2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"parser"}, scopes)
+}
@@ -0,0 +1,341 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package git
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// CodeOwnersPaths are the locations of the CODEOWNERS file, in the
+// order GitHub and GitLab look them up.
+var CodeOwnersPaths = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS", ".gitlab/CODEOWNERS"}
+
+// CodeOwners is a CODEOWNERS file of GitHub or GitLab.
+type CodeOwners struct {
+	// Path is the location of the file in the repository.
+	Path  string
+	Rules []CodeOwnersRule
+}
+
+// CodeOwnersRule assigns the files matching the pattern to their owners.
+type CodeOwnersRule struct {
+	Pattern string
+
+	// Owners are usernames ("@alice"), teams ("@acme/docs") or emails.
+	Owners []string
+
+	// Section is the GitLab section of the rule, e.g. "Documentation".
+	Section string
+}
+
+// ParseCodeOwners parses the content of a CODEOWNERS file. The rules
+// without owners of a GitLab section get the default owners of the section.
+func ParseCodeOwners(r io.Reader) *CodeOwners {
+	co := &CodeOwners{}
+	var section string
+	var defaults []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if i := strings.Index(line, " #"); i >= 0 {
+			line = strings.TrimSpace(line[:i])
+		}
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		// [Section], ^[Optional section] or [Section][2] @default-owner
+		if name, ok := strings.CutPrefix(strings.TrimPrefix(line, "^"), "["); ok {
+			if end := strings.Index(name, "]"); end >= 0 {
+				section = name[:end]
+				rest := name[end+1:]
+				if strings.HasPrefix(rest, "[") {
+					rest = rest[strings.Index(rest, "]")+1:]
+				}
+				defaults = strings.Fields(rest)
+				continue
+			}
+		}
+
+		fields := strings.Fields(strings.ReplaceAll(line, `\ `, "\x00"))
+		rule := CodeOwnersRule{
+			Pattern: strings.ReplaceAll(fields[0], "\x00", " "),
+			Owners:  fields[1:],
+			Section: section,
+		}
+		if len(rule.Owners) == 0 {
+			rule.Owners = defaults
+		}
+		co.Rules = append(co.Rules, rule)
+	}
+	return co
+}
+
+// CodeOwners reads the CODEOWNERS file of the worktree or, for bare
+// repositories, of HEAD. It returns fs.ErrNotExist when there's none.
+func (r *Repository) CodeOwners() (*CodeOwners, error) {
+	for _, path := range CodeOwnersPaths {
+		f, err := r.open(path)
+		if errors.Is(err, fs.ErrNotExist) || errors.Is(err, object.ErrFileNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		defer f.Close()
+		co := ParseCodeOwners(f)
+		co.Path = path
+		return co, nil
+	}
+	return nil, fs.ErrNotExist
+}
+
+// Owned returns the patterns owned by the person, by login or email.
+func (co *CodeOwners) Owned(id Identity) []string {
+	var patterns []string
+	for _, rule := range co.Rules {
+		for _, owner := range rule.Owners {
+			if ownedBy(owner, id) {
+				patterns = append(patterns, rule.Pattern)
+				break
+			}
+		}
+	}
+	return patterns
+}
+
+// Unmatched returns the owners who are none of the people, teams
+// ("@acme/docs") aren't people and are left out.
+func (co *CodeOwners) Unmatched(people []Identity) []string {
+	var owners []string
+	seen := make(map[string]struct{})
+	for _, rule := range co.Rules {
+		for _, owner := range rule.Owners {
+			if _, ok := seen[owner]; ok || strings.Contains(owner, "/") {
+				continue
+			}
+			seen[owner] = struct{}{}
+			if !slices.ContainsFunc(people, func(id Identity) bool { return ownedBy(owner, id) }) {
+				owners = append(owners, owner)
+			}
+		}
+	}
+	return owners
+}
+
+// ownedBy reports whether the owner, a login or an email, is the person.
+func ownedBy(owner string, id Identity) bool {
+	if login, ok := strings.CutPrefix(owner, "@"); ok {
+		return len(id.Login) != 0 && strings.EqualFold(login, id.Login)
+	}
+	return len(id.Email) != 0 && strings.EqualFold(owner, id.Email)
+}
+
+// Owners returns the owners of the file, the last matching rule wins
+// like on the forges. Nil means the file has no owner.
+func (co *CodeOwners) Owners(path string) []string {
+	var owners []string
+	for _, rule := range co.Rules {
+		if matchCodeOwnersPattern(rule.Pattern, path) {
+			owners = rule.Owners
+		}
+	}
+	return owners
+}
+
+// matchCodeOwnersPattern matches the gitignore-style patterns of CODEOWNERS,
+// a leading "/" anchors the pattern to the root and a trailing "/" matches
+// the content of a directory.
+func matchCodeOwnersPattern(pattern, path string) bool {
+	if pattern == "*" {
+		return true
+	}
+	anchored := strings.HasPrefix(pattern, "/") || strings.Contains(strings.TrimSuffix(pattern, "/"), "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+	dir := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+
+	parts := strings.Split(path, "/")
+	for start := range parts {
+		if anchored && start > 0 {
+			break
+		}
+		for end := start + 1; end <= len(parts); end++ {
+			if dir && end == len(parts) {
+				break
+			}
+			if matchGlob(pattern, strings.Join(parts[start:end], "/")) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchGlob matches "*" within a path segment and "**" across segments.
+func matchGlob(pattern, name string) bool {
+	if len(pattern) == 0 {
+		return len(name) == 0
+	}
+	if strings.HasPrefix(pattern, "**") {
+		rest := strings.TrimPrefix(strings.TrimPrefix(pattern, "**"), "/")
+		if len(rest) == 0 {
+			return true
+		}
+		for i := 0; i <= len(name); i++ {
+			if (i == 0 || name[i-1] == '/') && matchGlob(rest, name[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	switch pattern[0] {
+	case '*':
+		for i := 0; i <= len(name); i++ {
+			if matchGlob(pattern[1:], name[i:]) {
+				return true
+			}
+			if i < len(name) && name[i] == '/' {
+				break
+			}
+		}
+		return false
+	case '?':
+		return len(name) != 0 && name[0] != '/' && matchGlob(pattern[1:], name[1:])
+	}
+	return len(name) != 0 && pattern[0] == name[0] && matchGlob(pattern[1:], name[1:])
+}
+
+// CodeOwnersOptions customizes the rules generated from the ownership.
+type CodeOwnersOptions struct {
+	// Threshold is the minimum share (0-1) of an owner.
+	Threshold float64
+
+	// MinOwners completes the owners above the threshold with the next
+	// biggest shares, MaxOwners keeps the biggest ones, 0 means unlimited.
+	MinOwners int
+	MaxOwners int
+
+	// Teams maps the team handles to their members, logins or emails,
+	// e.g. {"@acme/docs": ["alice", "bob@example.com"]}. The members are
+	// written as their team.
+	Teams map[string][]string
+
+	// Pinned are the rules written as is after the generated ones, they
+	// replace the generated rules of the same pattern.
+	Pinned []CodeOwnersRule
+}
+
+// GenerateCodeOwners returns the rules of the directories, "*" for the
+// root and "/dir/" for the others. The people without login nor email
+// can't be owners, the directories owned like their parent are left out.
+func GenerateCodeOwners(ownership []DirectoryOwnership, opts CodeOwnersOptions) *CodeOwners {
+	pinned := make(map[string]struct{})
+	for _, rule := range opts.Pinned {
+		pinned[rule.Pattern] = struct{}{}
+	}
+
+	co := &CodeOwners{}
+	owners := make(map[string][]string)
+	for _, d := range ownership {
+		var handles []string
+		for _, s := range d.Shares {
+			if opts.MaxOwners > 0 && len(handles) == opts.MaxOwners {
+				break
+			}
+			if s.Share < opts.Threshold && len(handles) >= opts.MinOwners {
+				break
+			}
+			handle := ownerHandle(s.Identity, opts.Teams)
+			if len(handle) != 0 && !slices.Contains(handles, handle) {
+				handles = append(handles, handle)
+			}
+		}
+		owners[d.Path] = handles
+
+		if len(handles) == 0 || slices.Equal(handles, owners[parentDir(d.Path, owners)]) && len(d.Path) != 0 {
+			continue
+		}
+		pattern := "*"
+		if len(d.Path) != 0 {
+			pattern = "/" + d.Path + "/"
+		}
+		if _, ok := pinned[pattern]; !ok {
+			co.Rules = append(co.Rules, CodeOwnersRule{Pattern: pattern, Owners: handles})
+		}
+	}
+	co.Rules = append(co.Rules, opts.Pinned...)
+	return co
+}
+
+// parentDir returns the closest parent of the directory with owners.
+func parentDir(dir string, owners map[string][]string) string {
+	for len(dir) != 0 {
+		dir = path.Dir(dir)
+		if dir == "." {
+			dir = ""
+		}
+		if len(owners[dir]) != 0 {
+			break
+		}
+	}
+	return dir
+}
+
+// ownerHandle returns the team of the person, its "@login" or its email.
+func ownerHandle(id Identity, teams map[string][]string) string {
+	handle := id.Email
+	if len(id.Login) != 0 {
+		handle = "@" + id.Login
+	}
+
+	var names []string
+	for team := range teams {
+		names = append(names, team)
+	}
+	sort.Strings(names)
+	for _, team := range names {
+		for _, member := range teams[team] {
+			if !strings.Contains(member, "@") || strings.HasPrefix(member, "@") {
+				member = "@" + strings.TrimPrefix(member, "@")
+			}
+			if ownedBy(member, id) {
+				return team
+			}
+		}
+	}
+	return handle
+}
+
+// String renders the rules with aligned owners.
+func (co *CodeOwners) String() string {
+	width := 0
+	for _, rule := range co.Rules {
+		width = max(width, len(escapePattern(rule.Pattern)))
+	}
+	var sb strings.Builder
+	for _, rule := range co.Rules {
+		pattern := escapePattern(rule.Pattern)
+		if len(rule.Owners) == 0 {
+			sb.WriteString(pattern + "\n")
+			continue
+		}
+		fmt.Fprintf(&sb, "%-*s %s\n", width, pattern, strings.Join(rule.Owners, " "))
+	}
+	return sb.String()
+}
+
+func escapePattern(pattern string) string {
+	return strings.ReplaceAll(pattern, " ", `\ `)
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package git_test
+
+import (
+	"docwiz/internal/git"
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCodeOwners(t *testing.T) {
+	co := git.ParseCodeOwners(strings.NewReader(`# comment
+*            @alice
+/docs/       @bob carol@example.com # trailing comment
+*.go         @acme/gophers
+cli/**/flags.go @dave
+my\ file.txt @bob
+
+[Frontend] @erin
+web/
+^[Infra][2] @frank
+/deploy/     @alice
+`))
+
+	assert.Len(t, co.Rules, 7)
+	assert.Equal(t, git.CodeOwnersRule{Pattern: "my file.txt", Owners: []string{"@bob"}}, co.Rules[4])
+	assert.Equal(t, git.CodeOwnersRule{Pattern: "web/", Owners: []string{"@erin"}, Section: "Frontend"}, co.Rules[5])
+	assert.Equal(t, "Infra", co.Rules[6].Section)
+
+	testCases := []struct {
+		path     string
+		expected []string
+	}{
+		{"README.md", []string{"@alice"}},
+		{"docs/index.md", []string{"@bob", "carol@example.com"}},
+		{"docs", []string{"@alice"}},
+		{"internal/git/tag.go", []string{"@acme/gophers"}},
+		{"cli/flags.go", []string{"@dave"}},
+		{"cli/cmd/root/flags.go", []string{"@dave"}},
+		{"pkg/web/app.js", []string{"@erin"}},
+		{"deploy/k8s.yaml", []string{"@alice"}},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, co.Owners(tc.path), tc.path)
+	}
+
+	alice := git.Identity{Name: "Alice", Login: "Alice"}
+	carol := git.Identity{Name: "Carol", Email: "Carol@example.com"}
+	assert.Equal(t, []string{"*", "/deploy/"}, co.Owned(alice))
+	assert.Equal(t, []string{"/docs/"}, co.Owned(carol))
+	assert.Empty(t, co.Owned(git.Identity{Name: "bob", Email: "bob@example.com"}))
+	assert.Equal(t, []string{"@bob", "@dave", "@erin"}, co.Unmatched([]git.Identity{alice, carol, {Login: "frank"}}))
+}
+
+func TestRepositoryCodeOwners(t *testing.T) {
+	r := newTestRepo(t)
+	r.commit("init")
+	_, err := r.open().CodeOwners()
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+
+	r.write(map[string]string{"docs/CODEOWNERS": "/docs/ @bob\n"})
+	co, err := r.open().CodeOwners()
+	assert.NoError(t, err)
+	assert.Equal(t, "docs/CODEOWNERS", co.Path)
+}
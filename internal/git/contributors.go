@@ -0,0 +1,181 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package git
+
+import (
+	"crypto/md5"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// ContributorStats describes the contributions of a person to the history.
+type ContributorStats struct {
+	Identity
+
+	// URL links to the forge profile, empty when the login is unknown.
+	URL string
+
+	// Avatar is the forge avatar, or the Gravatar of the email.
+	Avatar string
+
+	// Commits counts the authored and co-authored commits.
+	Commits int
+
+	// Additions and Deletions count the lines changed by the commits,
+	// merge commits aren't counted.
+	Additions int
+	Deletions int
+
+	First time.Time
+	Last  time.Time
+
+	// Areas are the top-level directories touched by the commits,
+	// the most changed first.
+	Areas []string
+}
+
+// ContributorsOptions customizes the contributor statistics.
+type ContributorsOptions struct {
+	Identities IdentityOptions
+
+	// MaxAreas limits the number of areas per contributor, 0 means 3.
+	MaxAreas int
+}
+
+// Contributors returns the statistics of the people of the history, from
+// the most active one. Bots are left out.
+func (r *Repository) Contributors(opts ContributorsOptions) ([]ContributorStats, error) {
+	if opts.MaxAreas == 0 {
+		opts.MaxAreas = 3
+	}
+
+	ref, err := r.repo.Head()
+	if err != nil {
+		return nil, err
+	}
+	iter, err := r.repo.Log(&git.LogOptions{From: ref.Hash()})
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := r.Identities(opts.Identities)
+	stats := make(map[string]*ContributorStats)
+	areas := make(map[string]map[string]int)
+	err = iter.ForEach(func(c *object.Commit) error {
+		ids := resolver.CommitIdentities(c)
+
+		var changes object.FileStats
+		if c.NumParents() < 2 {
+			if changes, err = c.Stats(); err != nil {
+				return err
+			}
+		}
+
+		for _, id := range ids {
+			if id.Bot {
+				continue
+			}
+			s, ok := stats[id.Key()]
+			if !ok {
+				s = &ContributorStats{Identity: id, URL: r.profileURL(id), Avatar: r.avatarURL(id)}
+				stats[id.Key()] = s
+				areas[id.Key()] = make(map[string]int)
+			}
+			s.Commits++
+			when := c.Author.When
+			if s.First.IsZero() || when.Before(s.First) {
+				s.First = when
+			}
+			if when.After(s.Last) {
+				s.Last = when
+			}
+			for _, change := range changes {
+				s.Additions += change.Addition
+				s.Deletions += change.Deletion
+				if dir, _, ok := strings.Cut(change.Name, "/"); ok {
+					areas[id.Key()][dir] += change.Addition + change.Deletion
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var contributors []ContributorStats
+	for key, s := range stats {
+		s.Areas = topAreas(areas[key], opts.MaxAreas)
+		contributors = append(contributors, *s)
+	}
+	sort.Slice(contributors, func(i, j int) bool {
+		if contributors[i].Commits != contributors[j].Commits {
+			return contributors[i].Commits > contributors[j].Commits
+		}
+		return contributors[i].Name < contributors[j].Name
+	})
+	return contributors, nil
+}
+
+func topAreas(changes map[string]int, max int) []string {
+	var areas []string
+	for area := range changes {
+		areas = append(areas, area)
+	}
+	sort.Slice(areas, func(i, j int) bool {
+		if changes[areas[i]] != changes[areas[j]] {
+			return changes[areas[i]] > changes[areas[j]]
+		}
+		return areas[i] < areas[j]
+	})
+	if len(areas) > max {
+		areas = areas[:max]
+	}
+	return areas
+}
+
+// avatarURL returns the GitHub avatar of the login, or the Gravatar of the email.
+func (r *Repository) avatarURL(id Identity) string {
+	if len(id.Login) != 0 && r.forge.Kind() == ForgeGitHub && r.host == "github.com" {
+		return fmt.Sprintf("https://github.com/%s.png?size=100", id.Login)
+	}
+	if len(id.Email) == 0 {
+		return ""
+	}
+	hash := md5.Sum([]byte(strings.ToLower(strings.TrimSpace(id.Email))))
+	return fmt.Sprintf("https://www.gravatar.com/avatar/%x?s=100&d=identicon", hash)
+}
+
+// People returns the people of the history, the latest committers first.
+// Bots are left out.
+func (r *Repository) People(opts IdentityOptions) ([]Identity, error) {
+	ref, err := r.repo.Head()
+	if err != nil {
+		return nil, err
+	}
+	iter, err := r.repo.Log(&git.LogOptions{From: ref.Hash()})
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := r.Identities(opts)
+	var people []Identity
+	seen := make(map[string]struct{})
+	err = iter.ForEach(func(c *object.Commit) error {
+		for _, id := range resolver.CommitIdentities(c) {
+			if _, ok := seen[id.Key()]; ok || id.Bot {
+				continue
+			}
+			seen[id.Key()] = struct{}{}
+			people = append(people, id)
+		}
+		return nil
+	})
+	return people, err
+}
@@ -0,0 +1,347 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package git
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ForgeKind identifies the software hosting a repository.
+type ForgeKind string
+
+const (
+	ForgeGitHub    ForgeKind = "github"
+	ForgeGitLab    ForgeKind = "gitlab"
+	ForgeGitea     ForgeKind = "gitea"
+	ForgeBitbucket ForgeKind = "bitbucket"
+	ForgeAzure     ForgeKind = "azure"
+
+	// ForgeUnknown builds GitHub-like URLs, most forges understand them.
+	ForgeUnknown ForgeKind = ""
+)
+
+// ParseForgeKind converts s into a ForgeKind, Forgejo and Codeberg are Gitea.
+func ParseForgeKind(s string) (ForgeKind, error) {
+	switch k := ForgeKind(strings.ToLower(strings.TrimSpace(s))); k {
+	case ForgeGitHub, ForgeGitLab, ForgeGitea, ForgeBitbucket, ForgeAzure:
+		return k, nil
+	case "forgejo", "codeberg":
+		return ForgeGitea, nil
+	case "azuredevops", "azure-devops":
+		return ForgeAzure, nil
+	}
+	return ForgeUnknown, fmt.Errorf("unknown forge %q, expected github, gitlab, gitea, bitbucket or azure", s)
+}
+
+// ForgeHosts maps self-hosted instances to their forge,
+// e.g. {"git.example.com": ForgeGitLab}. It takes precedence over DetectForge.
+var ForgeHosts = map[string]ForgeKind{}
+
+// DetectForge guesses the forge from the hostname of a remote.
+func DetectForge(host string) ForgeKind {
+	host = strings.ToLower(host)
+	if kind, ok := ForgeHosts[host]; ok {
+		return kind
+	}
+	switch {
+	case strings.Contains(host, "github"):
+		return ForgeGitHub
+	case strings.Contains(host, "gitlab"):
+		return ForgeGitLab
+	case strings.Contains(host, "gitea"), strings.Contains(host, "forgejo"), host == "codeberg.org":
+		return ForgeGitea
+	case strings.Contains(host, "bitbucket"):
+		return ForgeBitbucket
+	case host == "dev.azure.com", host == "ssh.dev.azure.com", strings.HasSuffix(host, ".visualstudio.com"):
+		return ForgeAzure
+	}
+	return ForgeUnknown
+}
+
+// Forge builds the web URLs of a repository.
+type Forge interface {
+	Kind() ForgeKind
+
+	// RepoURL is the home page of the repository.
+	RepoURL() string
+	CommitURL(hash string) string
+	IssueURL(id string) string
+	PullRequestURL(id string) string
+	CompareURL(from, to string) string
+	TagURL(tag string) string
+	// TreeURL browses the files of a branch.
+	TreeURL(branch string) string
+	UserURL(user string) string
+	RawURL(ref, path string) string
+}
+
+// NewForge returns the forge of the repository owner/name served at
+// baseURL, e.g. "https://github.com". The owner of an Azure DevOps
+// repository is "organization/project".
+func NewForge(kind ForgeKind, baseURL, owner, name string) Forge {
+	base := forgeBase{url: strings.TrimSuffix(baseURL, "/"), owner: owner, name: name}
+	switch kind {
+	case ForgeGitLab:
+		return gitlabForge{base}
+	case ForgeGitea:
+		return giteaForge{base}
+	case ForgeBitbucket:
+		return bitbucketForge{base}
+	case ForgeAzure:
+		return azureForge{base}
+	case ForgeGitHub:
+		return githubForge{base}
+	}
+	return genericForge{githubForge{base}}
+}
+
+type forgeBase struct {
+	url   string
+	owner string
+	name  string
+}
+
+func (f forgeBase) RepoURL() string {
+	return fmt.Sprintf("%s/%s/%s", f.url, f.owner, f.name)
+}
+
+func (f forgeBase) UserURL(user string) string {
+	return fmt.Sprintf("%s/%s", f.url, user)
+}
+
+type githubForge struct{ forgeBase }
+
+func (githubForge) Kind() ForgeKind {
+	return ForgeGitHub
+}
+
+func (f githubForge) CommitURL(hash string) string {
+	return f.RepoURL() + "/commit/" + hash
+}
+
+func (f githubForge) IssueURL(id string) string {
+	return f.RepoURL() + "/issues/" + id
+}
+
+func (f githubForge) PullRequestURL(id string) string {
+	return f.RepoURL() + "/pull/" + id
+}
+
+func (f githubForge) CompareURL(from, to string) string {
+	return fmt.Sprintf("%s/compare/%s...%s", f.RepoURL(), from, to)
+}
+
+func (f githubForge) TagURL(tag string) string {
+	return f.RepoURL() + "/releases/tag/" + tag
+}
+
+func (f githubForge) TreeURL(branch string) string {
+	return f.RepoURL() + "/tree/" + branch
+}
+
+func (f githubForge) RawURL(ref, path string) string {
+	return f.RepoURL() + "/raw/" + ref + "/" + path
+}
+
+// genericForge is used for unknown hosts.
+type genericForge struct{ githubForge }
+
+func (genericForge) Kind() ForgeKind {
+	return ForgeUnknown
+}
+
+type gitlabForge struct{ forgeBase }
+
+func (gitlabForge) Kind() ForgeKind {
+	return ForgeGitLab
+}
+
+func (f gitlabForge) CommitURL(hash string) string {
+	return f.RepoURL() + "/-/commit/" + hash
+}
+
+func (f gitlabForge) IssueURL(id string) string {
+	return f.RepoURL() + "/-/issues/" + id
+}
+
+func (f gitlabForge) PullRequestURL(id string) string {
+	return f.RepoURL() + "/-/merge_requests/" + id
+}
+
+func (f gitlabForge) CompareURL(from, to string) string {
+	return fmt.Sprintf("%s/-/compare/%s...%s", f.RepoURL(), from, to)
+}
+
+func (f gitlabForge) TagURL(tag string) string {
+	return f.RepoURL() + "/-/tags/" + tag
+}
+
+func (f gitlabForge) TreeURL(branch string) string {
+	return f.RepoURL() + "/-/tree/" + branch
+}
+
+func (f gitlabForge) RawURL(ref, path string) string {
+	return f.RepoURL() + "/-/raw/" + ref + "/" + path
+}
+
+type giteaForge struct{ forgeBase }
+
+func (giteaForge) Kind() ForgeKind {
+	return ForgeGitea
+}
+
+func (f giteaForge) CommitURL(hash string) string {
+	return f.RepoURL() + "/commit/" + hash
+}
+
+func (f giteaForge) IssueURL(id string) string {
+	return f.RepoURL() + "/issues/" + id
+}
+
+func (f giteaForge) PullRequestURL(id string) string {
+	return f.RepoURL() + "/pulls/" + id
+}
+
+func (f giteaForge) CompareURL(from, to string) string {
+	return fmt.Sprintf("%s/compare/%s...%s", f.RepoURL(), from, to)
+}
+
+func (f giteaForge) TagURL(tag string) string {
+	return f.RepoURL() + "/releases/tag/" + tag
+}
+
+func (f giteaForge) TreeURL(branch string) string {
+	return f.RepoURL() + "/src/branch/" + branch
+}
+
+func (f giteaForge) RawURL(ref, path string) string {
+	return f.RepoURL() + "/raw/" + ref + "/" + path
+}
+
+type bitbucketForge struct{ forgeBase }
+
+func (bitbucketForge) Kind() ForgeKind {
+	return ForgeBitbucket
+}
+
+func (f bitbucketForge) CommitURL(hash string) string {
+	return f.RepoURL() + "/commits/" + hash
+}
+
+func (f bitbucketForge) IssueURL(id string) string {
+	return f.RepoURL() + "/issues/" + id
+}
+
+func (f bitbucketForge) PullRequestURL(id string) string {
+	return f.RepoURL() + "/pull-requests/" + id
+}
+
+// CompareURL lists the target first, the refs are separated by a carriage return.
+func (f bitbucketForge) CompareURL(from, to string) string {
+	return fmt.Sprintf("%s/branches/compare/%s%%0D%s", f.RepoURL(), to, from)
+}
+
+func (f bitbucketForge) TagURL(tag string) string {
+	return f.RepoURL() + "/src/" + tag
+}
+
+func (f bitbucketForge) TreeURL(branch string) string {
+	return f.RepoURL() + "/src/" + branch
+}
+
+func (f bitbucketForge) RawURL(ref, path string) string {
+	return f.RepoURL() + "/raw/" + ref + "/" + path
+}
+
+// azureForge serves https://dev.azure.com/organization/project/_git/repository,
+// its owner is "organization/project".
+type azureForge struct{ forgeBase }
+
+func (azureForge) Kind() ForgeKind {
+	return ForgeAzure
+}
+
+func (f azureForge) RepoURL() string {
+	return fmt.Sprintf("%s/%s/_git/%s", f.url, f.owner, f.name)
+}
+
+func (f azureForge) CommitURL(hash string) string {
+	return f.RepoURL() + "/commit/" + hash
+}
+
+// IssueURL links to a work item, they belong to the project.
+func (f azureForge) IssueURL(id string) string {
+	return fmt.Sprintf("%s/%s/_workitems/edit/%s", f.url, f.owner, id)
+}
+
+func (f azureForge) PullRequestURL(id string) string {
+	return f.RepoURL() + "/pullrequest/" + id
+}
+
+func (f azureForge) CompareURL(from, to string) string {
+	return fmt.Sprintf("%s/branchCompare?baseVersion=GT%s&targetVersion=GT%s",
+		f.RepoURL(), url.QueryEscape(from), url.QueryEscape(to))
+}
+
+func (f azureForge) TagURL(tag string) string {
+	return f.RepoURL() + "?version=GT" + url.QueryEscape(tag)
+}
+
+func (f azureForge) TreeURL(branch string) string {
+	return f.RepoURL() + "?version=GB" + url.QueryEscape(branch)
+}
+
+// UserURL returns the empty string, Azure DevOps has no public profiles.
+func (azureForge) UserURL(user string) string {
+	return ""
+}
+
+func (f azureForge) RawURL(ref, path string) string {
+	return fmt.Sprintf("%s?path=/%s&version=GB%s", f.RepoURL(), url.QueryEscape(path), url.QueryEscape(ref))
+}
+
+// localForge is used by repositories without remote, it has no URLs.
+type localForge struct{}
+
+func (localForge) Kind() ForgeKind {
+	return ForgeUnknown
+}
+
+func (localForge) RepoURL() string {
+	return ""
+}
+
+func (localForge) CommitURL(hash string) string {
+	return ""
+}
+
+func (localForge) IssueURL(id string) string {
+	return ""
+}
+
+func (localForge) PullRequestURL(id string) string {
+	return ""
+}
+
+func (localForge) CompareURL(from, to string) string {
+	return ""
+}
+
+func (localForge) TagURL(tag string) string {
+	return ""
+}
+
+func (localForge) TreeURL(branch string) string {
+	return ""
+}
+
+func (localForge) UserURL(user string) string {
+	return ""
+}
+
+func (localForge) RawURL(ref, path string) string {
+	return ""
+}
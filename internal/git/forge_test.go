@@ -0,0 +1,98 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package git_test
+
+import (
+	"docwiz/internal/git"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectForge(t *testing.T) {
+	testCases := []struct {
+		host     string
+		expected git.ForgeKind
+	}{
+		{"github.com", git.ForgeGitHub},
+		{"github.example.com", git.ForgeGitHub},
+		{"gitlab.com", git.ForgeGitLab},
+		{"codeberg.org", git.ForgeGitea},
+		{"bitbucket.org", git.ForgeBitbucket},
+		{"dev.azure.com", git.ForgeAzure},
+		{"acme.visualstudio.com", git.ForgeAzure},
+		{"git.example.com", git.ForgeUnknown},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, git.DetectForge(tc.host), tc.host)
+	}
+
+	git.ForgeHosts["git.example.com"] = git.ForgeGitea
+	defer delete(git.ForgeHosts, "git.example.com")
+	assert.Equal(t, git.ForgeGitea, git.DetectForge("Git.Example.com"))
+}
+
+func TestForgeURLs(t *testing.T) {
+	testCases := []struct {
+		kind                                  git.ForgeKind
+		base, owner                           string
+		commit, issue, pr, compare, tag, user string
+	}{
+		{
+			git.ForgeGitHub, "https://github.com", "acme",
+			"https://github.com/acme/widget/commit/abc",
+			"https://github.com/acme/widget/issues/1",
+			"https://github.com/acme/widget/pull/1",
+			"https://github.com/acme/widget/compare/v1...v2",
+			"https://github.com/acme/widget/releases/tag/v2",
+			"https://github.com/bob",
+		},
+		{
+			git.ForgeGitLab, "https://git.example.com", "group/sub",
+			"https://git.example.com/group/sub/widget/-/commit/abc",
+			"https://git.example.com/group/sub/widget/-/issues/1",
+			"https://git.example.com/group/sub/widget/-/merge_requests/1",
+			"https://git.example.com/group/sub/widget/-/compare/v1...v2",
+			"https://git.example.com/group/sub/widget/-/tags/v2",
+			"https://git.example.com/bob",
+		},
+		{
+			git.ForgeGitea, "https://codeberg.org", "acme",
+			"https://codeberg.org/acme/widget/commit/abc",
+			"https://codeberg.org/acme/widget/issues/1",
+			"https://codeberg.org/acme/widget/pulls/1",
+			"https://codeberg.org/acme/widget/compare/v1...v2",
+			"https://codeberg.org/acme/widget/releases/tag/v2",
+			"https://codeberg.org/bob",
+		},
+		{
+			git.ForgeBitbucket, "https://bitbucket.org", "acme",
+			"https://bitbucket.org/acme/widget/commits/abc",
+			"https://bitbucket.org/acme/widget/issues/1",
+			"https://bitbucket.org/acme/widget/pull-requests/1",
+			"https://bitbucket.org/acme/widget/branches/compare/v2%0Dv1",
+			"https://bitbucket.org/acme/widget/src/v2",
+			"https://bitbucket.org/bob",
+		},
+		{
+			git.ForgeAzure, "https://dev.azure.com", "acme/tools",
+			"https://dev.azure.com/acme/tools/_git/widget/commit/abc",
+			"https://dev.azure.com/acme/tools/_workitems/edit/1",
+			"https://dev.azure.com/acme/tools/_git/widget/pullrequest/1",
+			"https://dev.azure.com/acme/tools/_git/widget/branchCompare?baseVersion=GTv1&targetVersion=GTv2",
+			"https://dev.azure.com/acme/tools/_git/widget?version=GTv2",
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		f := git.NewForge(tc.kind, tc.base, tc.owner, "widget")
+		assert.Equal(t, tc.kind, f.Kind())
+		assert.Equal(t, tc.commit, f.CommitURL("abc"))
+		assert.Equal(t, tc.issue, f.IssueURL("1"))
+		assert.Equal(t, tc.pr, f.PullRequestURL("1"))
+		assert.Equal(t, tc.compare, f.CompareURL("v1", "v2"))
+		assert.Equal(t, tc.tag, f.TagURL("v2"))
+		assert.Equal(t, tc.user, f.UserURL("bob"))
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package git
+
+import (
+	"docwiz/internal/commit"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// history computes commit ranges, the ancestors of the tags are cached
+// because every tag is both the end of a release and the start of the next.
+type history struct {
+	firstParent bool
+	cache       map[plumbing.Hash]map[plumbing.Hash]struct{}
+}
+
+func newHistory(firstParent bool) *history {
+	return &history{firstParent: firstParent, cache: make(map[plumbing.Hash]map[plumbing.Hash]struct{})}
+}
+
+// ancestors returns the commits reachable from c, c included.
+func (h *history) ancestors(c *object.Commit) (map[plumbing.Hash]struct{}, error) {
+	if set, ok := h.cache[c.Hash]; ok {
+		return set, nil
+	}
+
+	set := map[plumbing.Hash]struct{}{c.Hash: {}}
+	queue := []*object.Commit{c}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		err := current.Parents().ForEach(func(parent *object.Commit) error {
+			if _, ok := set[parent.Hash]; !ok {
+				set[parent.Hash] = struct{}{}
+				queue = append(queue, parent)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	h.cache[c.Hash] = set
+	return set, nil
+}
+
+// between returns the commits reachable from to that aren't excluded,
+// latest first. Merge commits are skipped as their branch commits are
+// listed, unless only the first parents are followed.
+func (h *history) between(to *object.Commit, exclude map[plumbing.Hash]struct{}) ([]*object.Commit, error) {
+	var commits []*object.Commit
+
+	if h.firstParent {
+		for c := to; c != nil; {
+			if _, ok := exclude[c.Hash]; ok {
+				break
+			}
+			commits = append(commits, c)
+			if c.NumParents() == 0 {
+				break
+			}
+			parent, err := c.Parent(0)
+			if err != nil {
+				return nil, err
+			}
+			c = parent
+		}
+		return commits, nil
+	}
+
+	seen := make(map[plumbing.Hash]struct{})
+	queue := []*object.Commit{to}
+	for len(queue) > 0 {
+		c := queue[0]
+		queue = queue[1:]
+		if _, ok := seen[c.Hash]; ok {
+			continue
+		}
+		seen[c.Hash] = struct{}{}
+		if _, ok := exclude[c.Hash]; ok {
+			continue
+		}
+		if c.NumParents() < 2 {
+			commits = append(commits, c)
+		}
+		err := c.Parents().ForEach(func(parent *object.Commit) error {
+			queue = append(queue, parent)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(commits, func(i, j int) bool {
+		return commits[i].Committer.When.After(commits[j].Committer.When)
+	})
+	return commits, nil
+}
+
+var (
+	// mergePullRequestRegex matches the merge commits of GitHub pull requests.
+	// Example: "Merge pull request #12 from user/branch"
+	mergePullRequestRegex = regexp.MustCompile(`^Merge pull request #(\d+) from (\S+)`)
+
+	// mergeRequestFooterRegex matches the footer of GitLab merge commits.
+	// Example: "See merge request group/project!12"
+	mergeRequestFooterRegex = regexp.MustCompile(`(?m)^See merge request \S*!(\d+)\s*$`)
+
+	// mergeBranchRegex matches the default merge message of git.
+	// Example: "Merge branch 'feature' into 'main'"
+	mergeBranchRegex = regexp.MustCompile(`^Merge (?:remote-tracking )?branch '([^']+)'`)
+)
+
+// summarizeMerge returns the message describing a commit. The message of
+// a merge commit is replaced by the title of its pull or merge request,
+// followed by its reference, e.g. "feat: add parser (#12)".
+func summarizeMerge(c *object.Commit) string {
+	if c.NumParents() < 2 {
+		return c.Message
+	}
+
+	header, body, _ := strings.Cut(strings.TrimSpace(c.Message), "\n")
+	title := ""
+	for _, line := range strings.Split(body, "\n") {
+		if line = strings.TrimSpace(line); len(line) != 0 && !mergeRequestFooterRegex.MatchString(line) {
+			title = line
+			break
+		}
+	}
+
+	if m := mergePullRequestRegex.FindStringSubmatch(header); m != nil {
+		if len(title) == 0 {
+			title = m[2]
+		}
+		return fmt.Sprintf("%s (#%s)", title, m[1])
+	}
+	if m := mergeRequestFooterRegex.FindStringSubmatch(body); m != nil {
+		if len(title) == 0 {
+			title = header
+		}
+		return fmt.Sprintf("%s (!%s)", title, m[1])
+	}
+	if m := mergeBranchRegex.FindStringSubmatch(header); m != nil && len(title) != 0 {
+		return title
+	}
+	return c.Message
+}
+
+// RangeCommit is a commit listed by CommitRange.
+type RangeCommit struct {
+	Hash    string
+	Message string
+}
+
+// CommitRange returns the commits of a revision range, latest first.
+// "from..to" lists the commits reachable from to but not from from,
+// a single revision lists its whole history. Merge commits are skipped.
+func (r *Repository) CommitRange(spec string) ([]RangeCommit, error) {
+	from, to, ok := strings.Cut(spec, "..")
+	if !ok {
+		from, to = "", spec
+	}
+	if len(to) == 0 {
+		to = "HEAD"
+	}
+
+	h := newHistory(false)
+	head, err := r.resolve(to)
+	if err != nil {
+		return nil, err
+	}
+	exclude := map[plumbing.Hash]struct{}{}
+	if len(from) != 0 {
+		base, err := r.resolve(from)
+		if err != nil {
+			return nil, err
+		}
+		if exclude, err = h.ancestors(base); err != nil {
+			return nil, err
+		}
+	}
+
+	commits, err := h.between(head, exclude)
+	if err != nil {
+		return nil, err
+	}
+	var result []RangeCommit
+	for _, c := range commits {
+		result = append(result, RangeCommit{Hash: c.Hash.String(), Message: c.Message})
+	}
+	return result, nil
+}
+
+// Scopes returns the scopes of the latest commits of HEAD, the most used
+// first. At most limit commits are read.
+func (r *Repository) Scopes(limit int) ([]string, error) {
+	ref, err := r.repo.Head()
+	if err != nil {
+		return nil, err
+	}
+	iter, err := r.repo.Log(&git.LogOptions{From: ref.Hash()})
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	counts := make(map[string]int)
+	for i := 0; i < limit; i++ {
+		c, err := iter.Next()
+		if err != nil {
+			break
+		}
+		if scope := commit.Parse(c.Message).Scope; len(scope) != 0 {
+			counts[scope]++
+		}
+	}
+
+	var scopes []string
+	for scope := range counts {
+		scopes = append(scopes, scope)
+	}
+	sort.Slice(scopes, func(i, j int) bool {
+		if counts[scopes[i]] != counts[scopes[j]] {
+			return counts[scopes[i]] > counts[scopes[j]]
+		}
+		return scopes[i] < scopes[j]
+	})
+	return scopes, nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package git
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+)
+
+// HooksPath returns the directory of the git hooks: core.hooksPath when
+// it's set, relative paths being relative to the worktree, or the hooks
+// directory of .git.
+func (r *Repository) HooksPath() (string, error) {
+	conf, err := r.repo.ConfigScoped(config.GlobalScope)
+	if err != nil {
+		return "", err
+	}
+	if hooksPath := conf.Raw.Section("core").Option("hooksPath"); len(hooksPath) != 0 {
+		if rest, ok := strings.CutPrefix(hooksPath, "~/"); ok {
+			home, err := os.UserHomeDir()
+			if err != nil {
+				return "", err
+			}
+			return filepath.Join(home, rest), nil
+		}
+		if filepath.IsAbs(hooksPath) {
+			return hooksPath, nil
+		}
+		wt, err := r.repo.Worktree()
+		if err != nil {
+			return "", err
+		}
+		return filepath.Join(wt.Filesystem.Root(), hooksPath), nil
+	}
+
+	storage, ok := r.repo.Storer.(*filesystem.Storage)
+	if !ok {
+		return "", errors.New("the repository isn't stored on the disk")
+	}
+	return filepath.Join(storage.Filesystem().Root(), "hooks"), nil
+}
@@ -0,0 +1,240 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package git
+
+import (
+	"bufio"
+	"docwiz/internal/commit"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// Identity is a person of the history once the aliases are resolved.
+type Identity struct {
+	Name  string
+	Email string
+
+	// Login is the username on the forge, it's known for GitHub noreply
+	// emails and the logins of IdentityOptions.
+	Login string
+
+	Bot bool
+}
+
+// Key identifies the person, the lower case email or the name without email.
+func (i Identity) Key() string {
+	if len(i.Email) != 0 {
+		return strings.ToLower(i.Email)
+	}
+	return strings.ToLower(i.Name)
+}
+
+// IdentityOptions customizes the identity resolution.
+type IdentityOptions struct {
+	// Bots are the patterns matching the names or emails of bots, "*"
+	// matches any text (e.g. "*[bot]"). Nil uses DefaultBots.
+	Bots []string
+
+	// Logins maps the emails (or names) git can't relate to a forge
+	// account to their username, e.g. {"alice@corp.com": "alice"}.
+	Logins map[string]string
+}
+
+// DefaultBots matches the bots of the popular forges and dependency updaters.
+var DefaultBots = []string{
+	"*[bot]", "*[bot]@*",
+	"dependabot*", "renovate*", "greenkeeper*", "snyk-bot*",
+	"github-actions*", "gitlab-bot*", "semantic-release-bot*",
+}
+
+// Mailmap maps the names and emails of the commits to the canonical
+// ones, see gitmailmap(5).
+type Mailmap struct {
+	entries []mailmapEntry
+}
+
+type mailmapEntry struct {
+	properName, properEmail string
+	commitName, commitEmail string
+}
+
+// mailmapRegex matches "Name <email>" pairs, the name is optional.
+var mailmapRegex = regexp.MustCompile(`\s*([^<]*?)\s*<([^>]*)>`)
+
+// ParseMailmap parses the content of a .mailmap file.
+func ParseMailmap(r io.Reader) *Mailmap {
+	m := &Mailmap{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		pairs := mailmapRegex.FindAllStringSubmatch(line, 2)
+		switch len(pairs) {
+		case 1:
+			// Proper Name <proper@email>
+			m.entries = append(m.entries, mailmapEntry{properName: pairs[0][1], commitEmail: pairs[0][2]})
+		case 2:
+			// [Proper Name] <proper@email> [Commit Name] <commit@email>
+			m.entries = append(m.entries, mailmapEntry{
+				properName: pairs[0][1], properEmail: pairs[0][2],
+				commitName: pairs[1][1], commitEmail: pairs[1][2],
+			})
+		}
+	}
+	return m
+}
+
+// Resolve returns the canonical name and email, entries matching
+// both the name and the email win over the ones matching the email.
+func (m *Mailmap) Resolve(name, email string) (string, string) {
+	if m == nil {
+		return name, email
+	}
+	var match *mailmapEntry
+	for i := range m.entries {
+		e := &m.entries[i]
+		if !strings.EqualFold(e.commitEmail, email) {
+			continue
+		}
+		if len(e.commitName) == 0 {
+			if match == nil {
+				match = e
+			}
+		} else if strings.EqualFold(e.commitName, name) {
+			match = e
+			break
+		}
+	}
+	if match == nil {
+		return name, email
+	}
+	if len(match.properName) != 0 {
+		name = match.properName
+	}
+	if len(match.properEmail) != 0 {
+		email = match.properEmail
+	}
+	return name, email
+}
+
+// IdentityResolver resolves the people of the commits.
+type IdentityResolver struct {
+	mailmap *Mailmap
+	opts    IdentityOptions
+	bots    []*regexp.Regexp
+}
+
+// NewIdentityResolver returns a resolver applying the mailmap, which may be nil.
+func NewIdentityResolver(mailmap *Mailmap, opts IdentityOptions) *IdentityResolver {
+	if opts.Bots == nil {
+		opts.Bots = DefaultBots
+	}
+	logins := make(map[string]string)
+	for key, login := range opts.Logins {
+		logins[strings.ToLower(key)] = login
+	}
+	opts.Logins = logins
+
+	ir := &IdentityResolver{mailmap: mailmap, opts: opts}
+	for _, pattern := range opts.Bots {
+		// only "*" is special, bot names are full of brackets
+		expr := strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*")
+		ir.bots = append(ir.bots, regexp.MustCompile("(?i)^"+expr+"$"))
+	}
+	return ir
+}
+
+// Identities returns the resolver of the repository, it reads the .mailmap
+// of the worktree or, for bare repositories, of HEAD.
+func (r *Repository) Identities(opts IdentityOptions) *IdentityResolver {
+	return NewIdentityResolver(r.mailmap(), opts)
+}
+
+func (r *Repository) mailmap() *Mailmap {
+	f, err := r.open(".mailmap")
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+	return ParseMailmap(f)
+}
+
+// open opens a file of the worktree or, for bare repositories, of HEAD.
+func (r *Repository) open(name string) (io.ReadCloser, error) {
+	if wt, err := r.repo.Worktree(); err == nil {
+		return wt.Filesystem.Open(name)
+	}
+
+	ref, err := r.repo.Head()
+	if err != nil {
+		return nil, err
+	}
+	c, err := r.repo.CommitObject(ref.Hash())
+	if err != nil {
+		return nil, err
+	}
+	f, err := c.File(name)
+	if err != nil {
+		return nil, err
+	}
+	return f.Reader()
+}
+
+// noreplyRegex matches the private emails of GitHub.
+// Example: "12345+alice@users.noreply.github.com" or "alice@users.noreply.github.com"
+var noreplyRegex = regexp.MustCompile(`(?i)^(?:\d+\+)?([^@]+)@users\.noreply\.github\.com$`)
+
+// Resolve returns the identity of a signature.
+func (ir *IdentityResolver) Resolve(name, email string) Identity {
+	name, email = ir.mailmap.Resolve(strings.TrimSpace(name), strings.TrimSpace(email))
+	id := Identity{Name: name, Email: email}
+
+	if m := noreplyRegex.FindStringSubmatch(email); m != nil {
+		id.Login = m[1]
+	}
+	if login, ok := ir.opts.Logins[strings.ToLower(email)]; ok && len(email) != 0 {
+		id.Login = login
+	} else if login, ok := ir.opts.Logins[strings.ToLower(name)]; ok {
+		id.Login = login
+	}
+
+	for _, bot := range ir.bots {
+		if bot.MatchString(name) || bot.MatchString(email) {
+			id.Bot = true
+			break
+		}
+	}
+	return id
+}
+
+// signatureRegex matches the "Name <email>" value of trailers.
+var signatureRegex = regexp.MustCompile(`^\s*(.*?)\s*<([^>]*)>\s*$`)
+
+// CommitIdentities returns the author of the commit followed by
+// the co-authors of its Co-authored-by trailers, without duplicates.
+func (ir *IdentityResolver) CommitIdentities(c *object.Commit) []Identity {
+	ids := []Identity{ir.Resolve(c.Author.Name, c.Author.Email)}
+	seen := map[string]struct{}{ids[0].Key(): {}}
+	for _, f := range commit.Parse(c.Message).Footers {
+		if !strings.EqualFold(f.Token, "Co-authored-by") {
+			continue
+		}
+		m := signatureRegex.FindStringSubmatch(f.Value)
+		if m == nil {
+			continue
+		}
+		id := ir.Resolve(m[1], m[2])
+		if _, ok := seen[id.Key()]; ok {
+			continue
+		}
+		seen[id.Key()] = struct{}{}
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package git_test
+
+import (
+	"docwiz/internal/git"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMailmap(t *testing.T) {
+	m := git.ParseMailmap(strings.NewReader(`# comment
+Alice Smith <alice@example.com>
+<bob@example.com> <bob@old.example.com>
+Bob <bob@example.com> robert <ROBERT@example.com> # trailing comment
+`))
+
+	testCases := []struct {
+		name, email                 string
+		expectedName, expectedEmail string
+	}{
+		{"alice", "alice@example.com", "Alice Smith", "alice@example.com"},
+		{"Bobby", "bob@old.example.com", "Bobby", "bob@example.com"},
+		{"Robert", "robert@example.com", "Bob", "bob@example.com"},
+		{"Rob", "robert@example.com", "Rob", "robert@example.com"},
+		{"Carol", "carol@example.com", "Carol", "carol@example.com"},
+	}
+	for _, tc := range testCases {
+		name, email := m.Resolve(tc.name, tc.email)
+		assert.Equal(t, tc.expectedName, name, tc.name)
+		assert.Equal(t, tc.expectedEmail, email, tc.name)
+	}
+}
+
+func TestIdentityResolver(t *testing.T) {
+	ir := git.NewIdentityResolver(nil, git.IdentityOptions{Logins: map[string]string{"Carol@Corp.com": "carol-c"}})
+
+	assert.Equal(t, "alice", ir.Resolve("Alice", "12345+alice@users.noreply.github.com").Login)
+	assert.Equal(t, "bob", ir.Resolve("Bob", "bob@users.noreply.github.com").Login)
+	assert.Equal(t, "carol-c", ir.Resolve("Carol", "carol@corp.com").Login)
+	assert.Empty(t, ir.Resolve("Dave", "dave@example.com").Login)
+
+	assert.True(t, ir.Resolve("dependabot[bot]", "49699333+dependabot[bot]@users.noreply.github.com").Bot)
+	assert.True(t, ir.Resolve("renovate-bot", "bot@renovateapp.com").Bot)
+	assert.False(t, ir.Resolve("Alice", "alice@example.com").Bot)
+
+	ir = git.NewIdentityResolver(nil, git.IdentityOptions{Bots: []string{"ci@*"}})
+	assert.True(t, ir.Resolve("CI", "ci@example.com").Bot)
+	assert.False(t, ir.Resolve("dependabot[bot]", "dependabot@example.com").Bot)
+}
+
+func TestContributors(t *testing.T) {
+	r := newTestRepo(t)
+	wt, err := r.repo.Worktree()
+	assert.NoError(t, err)
+	assert.NoError(t, os.MkdirAll(filepath.Join(r.dir, "docs"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(r.dir, "docs", "a.md"), []byte("a\nb\n"), 0644))
+	_, err = wt.Add("docs/a.md")
+	assert.NoError(t, err)
+	r.commitAs("alice", "alice@old.example.com", "feat: a")
+	r.commitAs("Alice", "12345+alice@users.noreply.github.com", "feat: b")
+	r.commitAs("Bob", "bob@example.com", "fix: c\n\nCo-authored-by: Alice <12345+alice@users.noreply.github.com>\nCo-authored-by: Carol <carol@example.com>")
+	r.commitAs("dependabot[bot]", "49699333+dependabot[bot]@users.noreply.github.com", "chore: bump")
+	err = os.WriteFile(filepath.Join(r.dir, ".mailmap"), []byte("Alice <12345+alice@users.noreply.github.com> <alice@old.example.com>\n"), 0644)
+	assert.NoError(t, err)
+
+	contributors, err := r.open().Contributors(git.ContributorsOptions{})
+	assert.NoError(t, err)
+	if assert.Len(t, contributors, 3) {
+		alice := contributors[0]
+		assert.Equal(t, "Alice", alice.Name)
+		assert.Equal(t, "https://github.com/alice", alice.URL)
+		assert.Equal(t, "https://github.com/alice.png?size=100", alice.Avatar)
+		assert.Equal(t, 3, alice.Commits)
+		assert.Equal(t, 2, alice.Additions)
+		assert.Equal(t, []string{"docs"}, alice.Areas)
+		assert.True(t, alice.First.Before(alice.Last))
+
+		assert.Equal(t, []string{"Bob", "Carol"}, []string{contributors[1].Name, contributors[2].Name})
+		assert.Empty(t, contributors[1].URL)
+		assert.Contains(t, contributors[1].Avatar, "https://www.gravatar.com/avatar/")
+	}
+}
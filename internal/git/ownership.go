@@ -0,0 +1,233 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package git
+
+import (
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// OwnershipOptions customizes the ownership analysis.
+type OwnershipOptions struct {
+	Identities IdentityOptions
+
+	// Since leaves the older commits out of the commit share, the zero
+	// time counts the whole history.
+	Since time.Time
+
+	// Depth is the depth of the analyzed directories, 0 means 2.
+	Depth int
+
+	// BlameWeight is the weight (0-1) of the line authorship in the share,
+	// the rest is the commit share. 0 skips the blame, which is slow on
+	// large repositories.
+	BlameWeight float64
+
+	// Ignore leaves the matching files out, e.g. the .docwizignore patterns.
+	Ignore func(path string) bool
+}
+
+// DirectoryOwnership is the share of the people in a directory.
+type DirectoryOwnership struct {
+	// Path is the directory, empty for the root of the repository.
+	Path string
+
+	Commits int
+	Lines   int
+
+	// Shares are the people of the directory, the biggest share first.
+	Shares []OwnerShare
+}
+
+// OwnerShare is the part of a directory a person owns.
+type OwnerShare struct {
+	Identity
+
+	// Commits and Lines are the commits touching the directory and
+	// the blamed lines of its files.
+	Commits int
+	Lines   int
+
+	// Share is the weighted share (0-1) of the commits and lines.
+	Share float64
+}
+
+// Ownership returns the share of the people in every directory up to
+// the depth, the root first and then in path order. Bots are left out.
+func (r *Repository) Ownership(opts OwnershipOptions) ([]DirectoryOwnership, error) {
+	if opts.Depth == 0 {
+		opts.Depth = 2
+	}
+	if opts.Ignore == nil {
+		opts.Ignore = func(string) bool { return false }
+	}
+
+	ref, err := r.repo.Head()
+	if err != nil {
+		return nil, err
+	}
+	head, err := r.repo.CommitObject(ref.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := r.Identities(opts.Identities)
+	dirs := make(map[string]*directoryTally)
+	tally := func(dir string) *directoryTally {
+		t, ok := dirs[dir]
+		if !ok {
+			t = &directoryTally{people: make(map[string]*OwnerShare)}
+			dirs[dir] = t
+		}
+		return t
+	}
+
+	iter, err := r.repo.Log(&git.LogOptions{From: head.Hash})
+	if err != nil {
+		return nil, err
+	}
+	err = iter.ForEach(func(c *object.Commit) error {
+		if !opts.Since.IsZero() && c.Author.When.Before(opts.Since) {
+			return nil
+		}
+		if c.NumParents() > 1 {
+			return nil
+		}
+		stats, err := c.Stats()
+		if err != nil {
+			return err
+		}
+
+		touched := make(map[string]struct{})
+		for _, s := range stats {
+			if opts.Ignore(s.Name) {
+				continue
+			}
+			for _, dir := range parentDirs(s.Name, opts.Depth) {
+				touched[dir] = struct{}{}
+			}
+		}
+		ids := resolver.CommitIdentities(c)
+		for dir := range touched {
+			t := tally(dir)
+			t.commits++
+			for _, id := range ids {
+				if !id.Bot {
+					t.person(id).Commits++
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.BlameWeight > 0 {
+		files, err := head.Files()
+		if err != nil {
+			return nil, err
+		}
+		err = files.ForEach(func(f *object.File) error {
+			if opts.Ignore(f.Name) {
+				return nil
+			}
+			if binary, err := f.IsBinary(); err != nil || binary {
+				return nil
+			}
+			blame, err := git.Blame(head, f.Name)
+			if err != nil {
+				// the blame of some histories isn't supported, the commits still count
+				return nil
+			}
+			for _, line := range blame.Lines {
+				id := resolver.Resolve(line.AuthorName, line.Author)
+				for _, dir := range parentDirs(f.Name, opts.Depth) {
+					t := tally(dir)
+					t.lines++
+					if !id.Bot {
+						t.person(id).Lines++
+					}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var ownership []DirectoryOwnership
+	for dir, t := range dirs {
+		d := DirectoryOwnership{Path: dir, Commits: t.commits, Lines: t.lines}
+		for _, s := range t.people {
+			commitShare := float64(s.Commits) / float64(max(t.commits, 1))
+			lineShare := float64(s.Lines) / float64(max(t.lines, 1))
+			if opts.BlameWeight > 0 && t.lines != 0 {
+				s.Share = (1-opts.BlameWeight)*commitShare + opts.BlameWeight*lineShare
+			} else {
+				s.Share = commitShare
+			}
+			d.Shares = append(d.Shares, *s)
+		}
+		sort.Slice(d.Shares, func(i, j int) bool {
+			if d.Shares[i].Share != d.Shares[j].Share {
+				return d.Shares[i].Share > d.Shares[j].Share
+			}
+			return d.Shares[i].Name < d.Shares[j].Name
+		})
+		ownership = append(ownership, d)
+	}
+	sort.Slice(ownership, func(i, j int) bool {
+		return ownership[i].Path < ownership[j].Path
+	})
+	return ownership, nil
+}
+
+// BusFactor is the smallest number of people owning more than half of
+// the directory, 0 when nobody owns it.
+func (d DirectoryOwnership) BusFactor() int {
+	total := 0.0
+	for i, s := range d.Shares {
+		total += s.Share
+		if total > 0.5 {
+			return i + 1
+		}
+	}
+	return len(d.Shares)
+}
+
+type directoryTally struct {
+	commits int
+	lines   int
+	people  map[string]*OwnerShare
+}
+
+func (t *directoryTally) person(id Identity) *OwnerShare {
+	s, ok := t.people[id.Key()]
+	if !ok {
+		s = &OwnerShare{Identity: id}
+		t.people[id.Key()] = s
+	}
+	return s
+}
+
+// parentDirs returns the root ("") and the parent directories of the
+// file up to the depth, e.g. "", "cli" and "cli/cmd" for "cli/cmd/root.go".
+func parentDirs(file string, depth int) []string {
+	dirs := []string{""}
+	parts := strings.Split(path.Dir(file), "/")
+	if parts[0] == "." {
+		return dirs
+	}
+	for i := 1; i <= len(parts) && i <= depth; i++ {
+		dirs = append(dirs, strings.Join(parts[:i], "/"))
+	}
+	return dirs
+}
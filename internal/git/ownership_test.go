@@ -0,0 +1,98 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package git_test
+
+import (
+	"docwiz/internal/git"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// write adds the files to the index of the test repository.
+func (r *testRepo) write(files map[string]string) {
+	wt, err := r.repo.Worktree()
+	assert.NoError(r.t, err)
+	for name, content := range files {
+		path := filepath.Join(r.dir, filepath.FromSlash(name))
+		assert.NoError(r.t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(r.t, os.WriteFile(path, []byte(content), 0644))
+		_, err = wt.Add(name)
+		assert.NoError(r.t, err)
+	}
+}
+
+func TestOwnership(t *testing.T) {
+	r := newTestRepo(t)
+	r.write(map[string]string{"cli/cmd/a.go": "1\n2\n3\n4\n", "docs/x.md": "a\nb\n"})
+	r.commit("feat: a")
+	r.write(map[string]string{"docs/x.md": "a\nb\nc\nd\n"})
+	r.commitAs("Bob", "bob@example.com", "docs: c")
+	r.write(map[string]string{"docs/y.md": "e\n"})
+	r.commitAs("Bob", "bob@example.com", "docs: e")
+
+	ownership, err := r.open().Ownership(git.OwnershipOptions{
+		Identities:  git.IdentityOptions{Logins: map[string]string{"bob@example.com": "bob"}},
+		Depth:       1,
+		BlameWeight: 0.5,
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, ownership, 3) {
+		root, cli, docs := ownership[0], ownership[1], ownership[2]
+		assert.Equal(t, []string{"", "cli", "docs"}, []string{root.Path, cli.Path, docs.Path})
+		assert.Equal(t, 3, root.Commits)
+		assert.Equal(t, 9, root.Lines)
+
+		assert.Len(t, cli.Shares, 1)
+		assert.Equal(t, 1.0, cli.Shares[0].Share)
+
+		bob := docs.Shares[0]
+		assert.Equal(t, "bob", bob.Login)
+		assert.Equal(t, 2, bob.Commits)
+		assert.Equal(t, 3, bob.Lines)
+		assert.InDelta(t, 0.5*2/3+0.5*3/5, bob.Share, 1e-9)
+	}
+
+	ownership, err = r.open().Ownership(git.OwnershipOptions{
+		Ignore: func(path string) bool { return filepath.Ext(path) == ".md" },
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"", "cli", "cli/cmd"}, []string{ownership[0].Path, ownership[1].Path, ownership[2].Path})
+	assert.Equal(t, 1, ownership[0].Commits)
+}
+
+func TestGenerateCodeOwners(t *testing.T) {
+	alice := git.Identity{Name: "Alice", Email: "alice@example.com", Login: "alice"}
+	bob := git.Identity{Name: "Bob", Email: "bob@example.com"}
+	carol := git.Identity{Name: "Carol", Login: "carol"}
+	dave := git.Identity{Name: "Dave"}
+	ownership := []git.DirectoryOwnership{
+		{Path: "", Shares: []git.OwnerShare{{Identity: alice, Share: 0.6}, {Identity: bob, Share: 0.3}, {Identity: carol, Share: 0.1}}},
+		{Path: "cli", Shares: []git.OwnerShare{{Identity: alice, Share: 0.7}, {Identity: bob, Share: 0.3}}},
+		{Path: "cli/cmd", Shares: []git.OwnerShare{{Identity: dave, Share: 0.9}, {Identity: carol, Share: 0.1}}},
+		{Path: "docs", Shares: []git.OwnerShare{{Identity: carol, Share: 0.8}, {Identity: bob, Share: 0.2}}},
+		{Path: "web", Shares: []git.OwnerShare{{Identity: bob, Share: 1}}},
+	}
+
+	co := git.GenerateCodeOwners(ownership, git.CodeOwnersOptions{
+		Threshold: 0.25,
+		MinOwners: 1,
+		MaxOwners: 2,
+		Teams:     map[string][]string{"@acme/docs": {"carol"}},
+		Pinned:    []git.CodeOwnersRule{{Pattern: "/web/", Owners: []string{"@acme/web"}}},
+	})
+	assert.Equal(t, []git.CodeOwnersRule{
+		{Pattern: "*", Owners: []string{"@alice", "bob@example.com"}},
+		{Pattern: "/cli/cmd/", Owners: []string{"@acme/docs"}},
+		{Pattern: "/docs/", Owners: []string{"@acme/docs"}},
+		{Pattern: "/web/", Owners: []string{"@acme/web"}},
+	}, co.Rules)
+	assert.Equal(t, `*         @alice bob@example.com
+/cli/cmd/ @acme/docs
+/docs/    @acme/docs
+/web/     @acme/web
+`, co.String())
+}
@@ -0,0 +1,224 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package git
+
+import (
+	"docwiz/internal/commit"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// ReleaseNotesOptions selects the commits of the release notes.
+type ReleaseNotesOptions struct {
+	ChangelogOptions
+
+	// From is the revision of the previous release. Empty uses the latest
+	// tag reachable from To, or the whole history when there's none.
+	From string
+
+	// To is the revision being released, HEAD when empty.
+	To string
+
+	// Identities resolves the contributors.
+	Identities IdentityOptions
+}
+
+// ReleaseNotes is the model rendered by the templates of template/RELEASE_NOTES.
+type ReleaseNotes struct {
+	Owner string
+	Name  string
+	URL   string
+
+	// Release lists the commits of the range, its sections don't
+	// repeat the breaking changes.
+	Release Release
+
+	// Highlights are the commits with a "Highlight" footer,
+	// or the features when no commit has one.
+	Highlights []ChangelogCommit
+
+	Breaking []ChangelogCommit
+
+	Contributors []Contributor
+}
+
+// Contributor is an author or co-author of the released commits.
+type Contributor struct {
+	Name  string
+	Email string
+	Login string
+
+	// URL links to the forge profile, empty when the login is unknown.
+	URL string
+
+	Commits int
+
+	// FirstTime reports the authors whose first commit is part of the release.
+	FirstTime bool
+}
+
+// ReleaseNotes returns the notes of the commits reachable from opts.To
+// but not from opts.From.
+func (r *Repository) ReleaseNotes(opts ReleaseNotesOptions) (*ReleaseNotes, error) {
+	classifier := opts.Classifier
+	if classifier == nil {
+		classifier = commit.DefaultClassifier()
+	}
+	if len(opts.To) == 0 {
+		opts.To = "HEAD"
+	}
+
+	to, err := r.resolve(opts.To)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := r.Tags(opts.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	h := newHistory(opts.FirstParent)
+	var from *object.Commit
+	if len(opts.From) != 0 {
+		if from, err = r.resolve(opts.From); err != nil {
+			return nil, err
+		}
+	} else {
+		ancestors, err := h.ancestors(to)
+		if err != nil {
+			return nil, err
+		}
+		// the latest tag before the released commit
+		for _, tag := range tags {
+			if _, ok := ancestors[tag.Commit.Hash]; ok && tag.Commit.Hash != to.Hash {
+				opts.From, from = tag.Name, tag.Commit
+				break
+			}
+		}
+	}
+
+	exclude := map[plumbing.Hash]struct{}{}
+	if from != nil {
+		if exclude, err = h.ancestors(from); err != nil {
+			return nil, err
+		}
+	}
+	commits, err := h.between(to, exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	// name the release after the tag being released, if any
+	name := ""
+	for _, tag := range tags {
+		if tag.Name == opts.To || (opts.To == "HEAD" && tag.Commit.Hash == to.Hash) {
+			name = tag.Name
+			break
+		}
+	}
+
+	release := r.newRelease(TagInfo{Name: name, Commit: to}, opts.From, commits, classifier)
+	if len(name) == 0 && opts.To != "HEAD" {
+		release.URL = r.forge.TreeURL(opts.To)
+		if len(opts.From) != 0 {
+			release.CompareURL = r.forge.CompareURL(opts.From, opts.To)
+		}
+	}
+	release.Unreleased = len(name) == 0
+
+	notes := &ReleaseNotes{Owner: r.owner, Name: r.name, URL: r.forge.RepoURL(), Release: release}
+	notes.Release.Sections = nil
+	var features []ChangelogCommit
+	for _, s := range release.Sections {
+		var kept []ChangelogCommit
+		for _, c := range s.Commits {
+			if c.Breaking {
+				notes.Breaking = append(notes.Breaking, c)
+			} else {
+				kept = append(kept, c)
+			}
+			if c.Type == "feat" {
+				features = append(features, c)
+			}
+			for _, f := range c.Message.Footers {
+				if strings.EqualFold(f.Token, "Highlight") {
+					notes.Highlights = append(notes.Highlights, c)
+					break
+				}
+			}
+		}
+		if len(kept) != 0 {
+			notes.Release.Sections = append(notes.Release.Sections, ReleaseSection{Title: s.Title, Commits: kept})
+		}
+	}
+	if len(notes.Highlights) == 0 {
+		notes.Highlights = features
+	}
+
+	if notes.Contributors, err = r.contributors(commits, from, r.Identities(opts.Identities)); err != nil {
+		return nil, err
+	}
+	return notes, nil
+}
+
+// contributors counts the commits of each person, people that never
+// committed before the previous release are first-time contributors.
+func (r *Repository) contributors(commits []*object.Commit, previous *object.Commit, resolver *IdentityResolver) ([]Contributor, error) {
+	known := make(map[string]struct{})
+	if previous != nil {
+		iter, err := r.repo.Log(&git.LogOptions{From: previous.Hash})
+		if err != nil {
+			return nil, err
+		}
+		err = iter.ForEach(func(c *object.Commit) error {
+			for _, id := range resolver.CommitIdentities(c) {
+				known[id.Key()] = struct{}{}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var contributors []Contributor
+	index := make(map[string]int)
+	// commits are listed from the latest, count from the oldest so that
+	// contributors appear in order of their first commit
+	for i := len(commits) - 1; i >= 0; i-- {
+		for _, id := range resolver.CommitIdentities(commits[i]) {
+			if id.Bot {
+				continue
+			}
+			if j, ok := index[id.Key()]; ok {
+				contributors[j].Commits++
+				continue
+			}
+			_, ok := known[id.Key()]
+			index[id.Key()] = len(contributors)
+			contributors = append(contributors, Contributor{
+				Name:      id.Name,
+				Email:     id.Email,
+				Login:     id.Login,
+				URL:       r.profileURL(id),
+				Commits:   1,
+				FirstTime: previous != nil && !ok,
+			})
+		}
+	}
+	return contributors, nil
+}
+
+// resolve returns the commit a revision (tag, branch, hash, HEAD~2, ...) points to.
+func (r *Repository) resolve(rev string) (*object.Commit, error) {
+	hash, err := r.repo.ResolveRevision(plumbing.Revision(rev))
+	if err != nil {
+		return nil, err
+	}
+	return r.repo.CommitObject(*hash)
+}
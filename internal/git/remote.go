@@ -0,0 +1,141 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package git
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Remote is the parsed URL of a git remote.
+type Remote struct {
+	// Name is the name of the remote, e.g. "origin".
+	Name string
+
+	// URL is the URL as configured, credentials included.
+	URL string
+
+	// Scheme is https, http, ssh, git or file. scp-like
+	// URLs (git@host:owner/name) are ssh.
+	Scheme string
+
+	Host string
+	Port string
+
+	// Owner is the namespace of the repository, nested groups are kept
+	// (e.g. "group/subgroup") and Azure DevOps owners are "organization/project".
+	Owner string
+
+	Repo string
+
+	// Local reports remotes pointing at a path on the disk, they have no host.
+	Local bool
+}
+
+// WebURL returns the base URL of the forge serving the remote, e.g.
+// "https://github.com". The port is only kept for http(s) remotes,
+// ssh ports aren't the ones of the web interface.
+func (r *Remote) WebURL() string {
+	if r.Local {
+		return ""
+	}
+	scheme := "https"
+	if r.Scheme == "http" {
+		scheme = "http"
+	}
+	host := r.Host
+	if len(r.Port) != 0 && (r.Scheme == "http" || r.Scheme == "https") {
+		host += ":" + r.Port
+	}
+	return fmt.Sprintf("%s://%s", scheme, host)
+}
+
+var (
+	// scpRegex matches scp-like remotes.
+	// Example: "git@github.com:owner/name.git" or "github.com:owner/name"
+	scpRegex = regexp.MustCompile(`^(?:([^@/]+)@)?([^@/:]+):(.*)$`)
+
+	// windowsPathRegex matches absolute Windows paths, they look like scp remotes.
+	// Example: "C:\repos\name" or "C:/repos/name"
+	windowsPathRegex = regexp.MustCompile(`^[a-zA-Z]:[\\/]`)
+)
+
+// ParseRemoteURL parses the URL of a remote: scp-like, ssh://, git://,
+// http(s):// with credentials or ports, file:// and local paths.
+func ParseRemoteURL(raw string) (*Remote, error) {
+	raw = strings.TrimSpace(raw)
+	r := &Remote{URL: raw}
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("empty remote url")
+	}
+
+	var repoPath string
+	switch {
+	case strings.HasPrefix(raw, "file://"):
+		r.Scheme, r.Local = "file", true
+		repoPath = strings.TrimPrefix(raw, "file://")
+	case strings.Contains(raw, "://"):
+		u, err := url.Parse(raw)
+		if err != nil {
+			return nil, err
+		}
+		r.Scheme = strings.ToLower(u.Scheme)
+		if r.Scheme == "git+ssh" || r.Scheme == "ssh+git" {
+			r.Scheme = "ssh"
+		}
+		r.Host = u.Hostname()
+		r.Port = u.Port()
+		repoPath = u.Path
+	case windowsPathRegex.MatchString(raw), strings.HasPrefix(raw, "/"),
+		strings.HasPrefix(raw, "."), strings.HasPrefix(raw, "~"), !strings.Contains(raw, ":"):
+		r.Scheme, r.Local = "file", true
+		repoPath = raw
+	default:
+		m := scpRegex.FindStringSubmatch(raw)
+		if m == nil {
+			return nil, fmt.Errorf("unsupported remote url %q", raw)
+		}
+		r.Scheme, r.Host = "ssh", m[2]
+		repoPath = m[3]
+	}
+
+	if r.Local {
+		r.Repo = strings.TrimSuffix(filepath.Base(filepath.Clean(repoPath)), ".git")
+		return r, nil
+	}
+	if len(r.Host) == 0 {
+		return nil, fmt.Errorf("remote url %q has no host", raw)
+	}
+
+	segments := strings.Split(strings.Trim(path.Clean("/"+repoPath), "/"), "/")
+	segments[len(segments)-1] = strings.TrimSuffix(segments[len(segments)-1], ".git")
+
+	switch host := strings.ToLower(r.Host); {
+	case host == "ssh.dev.azure.com" || host == "vs-ssh.visualstudio.com":
+		// git@ssh.dev.azure.com:v3/organization/project/repository
+		r.Host = "dev.azure.com"
+		if len(segments) > 0 && segments[0] == "v3" {
+			segments = segments[1:]
+		}
+	case host == "dev.azure.com" || strings.HasSuffix(host, ".visualstudio.com"):
+		// https://dev.azure.com/organization/project/_git/repository
+		for i, s := range segments {
+			if s == "_git" {
+				segments = append(segments[:i:i], segments[i+1:]...)
+				break
+			}
+		}
+	}
+
+	if len(segments) < 2 || len(segments[len(segments)-1]) == 0 {
+		return nil, fmt.Errorf("remote url %q has no owner and repository", raw)
+	}
+	r.Owner = strings.Join(segments[:len(segments)-1], "/")
+	r.Repo = segments[len(segments)-1]
+	return r, nil
+}
@@ -0,0 +1,277 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package git
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// ReportOptions customizes the history statistics of the health report.
+type ReportOptions struct {
+	Identities IdentityOptions
+
+	// Since leaves the older commits out of the churn of the files, the
+	// zero time counts the whole history. The file ages always do.
+	Since time.Time
+
+	// Weeks is the number of weeks of the commit cadence, 0 means 12.
+	Weeks int
+
+	// TrendDays is the length of the two periods compared by the activity
+	// trends of the contributors, 0 means 90.
+	TrendDays int
+
+	// Now is the end of the periods, the zero time means now.
+	Now time.Time
+
+	// Ignore leaves the matching files out, e.g. the .docwizignore patterns.
+	Ignore func(path string) bool
+}
+
+// Report holds the history statistics of the health report.
+type Report struct {
+	// Commits counts the commits of the history, merges included.
+	Commits int
+
+	// Files are the files of HEAD, the most churned first.
+	Files []FileHistory
+
+	// Weeks is the commit cadence, the oldest week first.
+	Weeks []WeekActivity
+
+	// Activity compares the commits of the people over the last two
+	// periods, the most active first. Bots are left out.
+	Activity []ContributorActivity
+}
+
+// FileHistory describes the changes of a file.
+type FileHistory struct {
+	Path string
+
+	// Commits, Additions, Deletions and Authors count the changes of the period.
+	Commits   int
+	Additions int
+	Deletions int
+	Authors   int
+
+	// Created and Modified are the first and the last change of the history.
+	Created  time.Time
+	Modified time.Time
+}
+
+// Churn is the number of changed lines of the period.
+func (f FileHistory) Churn() int {
+	return f.Additions + f.Deletions
+}
+
+// WeekActivity counts the commits of a week, which starts on Monday.
+type WeekActivity struct {
+	Start   time.Time
+	Commits int
+	Authors int
+}
+
+// ContributorActivity compares the commits of a person over two periods.
+type ContributorActivity struct {
+	Identity
+
+	// Recent counts the commits of the last period, Previous the ones of
+	// the period before.
+	Recent   int
+	Previous int
+
+	Last time.Time
+}
+
+// Trend sums up the activity: new, up, down, steady or inactive.
+func (a ContributorActivity) Trend() string {
+	switch {
+	case a.Recent == 0:
+		return "inactive"
+	case a.Previous == 0:
+		return "new"
+	case a.Recent > a.Previous:
+		return "up"
+	case a.Recent < a.Previous:
+		return "down"
+	}
+	return "steady"
+}
+
+// Report walks the history once and returns its statistics.
+func (r *Repository) Report(opts ReportOptions) (*Report, error) {
+	if opts.Weeks == 0 {
+		opts.Weeks = 12
+	}
+	if opts.TrendDays == 0 {
+		opts.TrendDays = 90
+	}
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
+	if opts.Ignore == nil {
+		opts.Ignore = func(string) bool { return false }
+	}
+
+	ref, err := r.repo.Head()
+	if err != nil {
+		return nil, err
+	}
+	head, err := r.repo.CommitObject(ref.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]*FileHistory)
+	authors := make(map[string]map[string]struct{})
+	tree, err := head.Files()
+	if err != nil {
+		return nil, err
+	}
+	err = tree.ForEach(func(f *object.File) error {
+		if !opts.Ignore(f.Name) {
+			files[f.Name] = &FileHistory{Path: f.Name}
+			authors[f.Name] = make(map[string]struct{})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	firstWeek := weekStart(opts.Now).AddDate(0, 0, -7*(opts.Weeks-1))
+	weeks := make([]WeekActivity, opts.Weeks)
+	weekAuthors := make([]map[string]struct{}, opts.Weeks)
+	for i := range weeks {
+		weeks[i].Start = firstWeek.AddDate(0, 0, 7*i)
+		weekAuthors[i] = make(map[string]struct{})
+	}
+
+	recent := opts.Now.AddDate(0, 0, -opts.TrendDays)
+	previous := recent.AddDate(0, 0, -opts.TrendDays)
+	activity := make(map[string]*ContributorActivity)
+
+	report := &Report{}
+	resolver := r.Identities(opts.Identities)
+	iter, err := r.repo.Log(&git.LogOptions{From: head.Hash})
+	if err != nil {
+		return nil, err
+	}
+	err = iter.ForEach(func(c *object.Commit) error {
+		report.Commits++
+		when := c.Author.When
+		ids := resolver.CommitIdentities(c)
+
+		days := math.Round(weekStart(when.In(opts.Now.Location())).Sub(firstWeek).Hours() / 24)
+		if i := int(days) / 7; days >= 0 && i < len(weeks) {
+			weeks[i].Commits++
+			for _, id := range ids {
+				if !id.Bot {
+					weekAuthors[i][id.Key()] = struct{}{}
+				}
+			}
+		}
+
+		for _, id := range ids {
+			if id.Bot {
+				continue
+			}
+			a, ok := activity[id.Key()]
+			if !ok {
+				a = &ContributorActivity{Identity: id}
+				activity[id.Key()] = a
+			}
+			switch {
+			case when.After(opts.Now):
+			case !when.Before(recent):
+				a.Recent++
+			case !when.Before(previous):
+				a.Previous++
+			}
+			if when.After(a.Last) {
+				a.Last = when
+			}
+		}
+
+		if c.NumParents() > 1 {
+			return nil
+		}
+		stats, err := c.Stats()
+		if err != nil {
+			return err
+		}
+		for _, s := range stats {
+			f, ok := files[s.Name]
+			if !ok {
+				continue
+			}
+			if f.Created.IsZero() || when.Before(f.Created) {
+				f.Created = when
+			}
+			if when.After(f.Modified) {
+				f.Modified = when
+			}
+			if !opts.Since.IsZero() && when.Before(opts.Since) {
+				continue
+			}
+			f.Commits++
+			f.Additions += s.Addition
+			f.Deletions += s.Deletion
+			for _, id := range ids {
+				authors[s.Name][id.Key()] = struct{}{}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for name, f := range files {
+		f.Authors = len(authors[name])
+		report.Files = append(report.Files, *f)
+	}
+	sort.Slice(report.Files, func(i, j int) bool {
+		a, b := report.Files[i], report.Files[j]
+		if a.Churn() != b.Churn() {
+			return a.Churn() > b.Churn()
+		}
+		if a.Commits != b.Commits {
+			return a.Commits > b.Commits
+		}
+		return a.Path < b.Path
+	})
+
+	for i := range weeks {
+		weeks[i].Authors = len(weekAuthors[i])
+	}
+	report.Weeks = weeks
+
+	for _, a := range activity {
+		report.Activity = append(report.Activity, *a)
+	}
+	sort.Slice(report.Activity, func(i, j int) bool {
+		a, b := report.Activity[i], report.Activity[j]
+		if a.Recent != b.Recent {
+			return a.Recent > b.Recent
+		}
+		if !a.Last.Equal(b.Last) {
+			return a.Last.After(b.Last)
+		}
+		return a.Name < b.Name
+	})
+	return report, nil
+}
+
+// weekStart returns the Monday starting the week of t, at midnight.
+func weekStart(t time.Time) time.Time {
+	t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	offset := (int(t.Weekday()) + 6) % 7
+	return t.AddDate(0, 0, -offset)
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package git_test
+
+import (
+	"docwiz/internal/git"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReport(t *testing.T) {
+	r := newTestRepo(t)
+	r.when = time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+	r.write(map[string]string{"a.go": "1\n", "old.md": "x\n"})
+	r.commit("feat: a")
+	r.commitAs("Dave", "dave@example.com", "chore: nothing")
+
+	r.when = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	r.write(map[string]string{"a.go": "1\n2\n3\n"})
+	r.commit("feat: more a")
+	r.write(map[string]string{"a.go": "1\n3\n", "b.go": "b\n"})
+	r.commitAs("Bob", "bob@example.com", "fix: a")
+	r.commitAs("Bob", "bob@example.com", "docs: nothing")
+
+	report, err := r.open().Report(git.ReportOptions{
+		Since:     time.Date(2024, 12, 15, 0, 0, 0, 0, time.UTC),
+		Weeks:     2,
+		TrendDays: 20,
+		Now:       time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 5, report.Commits)
+
+	if assert.Len(t, report.Files, 3) {
+		a, b, old := report.Files[0], report.Files[1], report.Files[2]
+		assert.Equal(t, []string{"a.go", "b.go", "old.md"}, []string{a.Path, b.Path, old.Path})
+		assert.Equal(t, 2, a.Commits)
+		assert.Equal(t, 3, a.Churn())
+		assert.Equal(t, 2, a.Authors)
+		assert.Equal(t, time.Date(2024, 12, 1, 1, 0, 0, 0, time.UTC), a.Created.UTC())
+		assert.Equal(t, time.Date(2025, 1, 1, 2, 0, 0, 0, time.UTC), a.Modified.UTC())
+		assert.Equal(t, 0, old.Commits)
+		assert.Equal(t, old.Created, old.Modified)
+	}
+
+	if assert.Len(t, report.Weeks, 2) {
+		assert.Equal(t, time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC), report.Weeks[0].Start)
+		assert.Equal(t, 3, report.Weeks[0].Commits)
+		assert.Equal(t, 2, report.Weeks[0].Authors)
+		assert.Equal(t, 0, report.Weeks[1].Commits)
+	}
+
+	trends := make(map[string]string)
+	for _, a := range report.Activity {
+		trends[a.Name] = a.Trend()
+	}
+	assert.Equal(t, map[string]string{"Alice": "steady", "Bob": "new", "Dave": "inactive"}, trends)
+	assert.Equal(t, "Bob", report.Activity[0].Name)
+}
+
+func TestBusFactor(t *testing.T) {
+	d := git.DirectoryOwnership{Shares: []git.OwnerShare{{Share: 0.4}, {Share: 0.3}, {Share: 0.3}}}
+	assert.Equal(t, 2, d.BusFactor())
+	d.Shares = d.Shares[:0]
+	assert.Equal(t, 0, d.BusFactor())
+	d.Shares = []git.OwnerShare{{Share: 0.9}, {Share: 0.1}}
+	assert.Equal(t, 1, d.BusFactor())
+}
@@ -5,16 +5,13 @@ package git
 
 import (
 	"fmt"
-	"io"
-	"net/url"
+	"path/filepath"
 	"regexp"
 	"sort"
 	"strings"
 	"unicode"
 
 	"github.com/go-git/go-git/v5"
-	"github.com/go-git/go-git/v5/plumbing"
-	"github.com/go-git/go-git/v5/plumbing/object"
 )
 
 type RepoKind uint
@@ -29,12 +26,22 @@ const (
 type Repository struct {
 	repo     *git.Repository
 	kind     RepoKind
+	forge    Forge
+	remote   *Remote
 	owner    string
 	name     string
 	url      string
+	host     string
 	repoPath string
 }
 
+// RemotePreference lists the remotes describing the project in order of
+// preference, e.g. "upstream" comes first in forks. Other remotes are
+// used when none of them exists.
+var RemotePreference = []string{"upstream", "origin"}
+
+// New opens the repository at repoPath. Without a usable remote, the
+// repository is local-only: it's named after its directory and has no links.
 func New(repoPath string) (*Repository, error) {
 	repo, err := git.PlainOpen(repoPath)
 	if err != nil {
@@ -48,240 +55,122 @@ func New(repoPath string) (*Repository, error) {
 		return nil, err
 	}
 
-	for _, remote := range remotes {
-		if remote.Config().Name == "origin" {
-			remoteURL := remote.Config().URLs[0]
-
-			// git@github.com:username/repository.git
-			if strings.HasPrefix(remoteURL, "git@") {
-				remoteURL = strings.Replace(remoteURL, ":", "/", 1)
-				remoteURL = strings.Replace(remoteURL, "git@", "https://", 1)
-			}
-
-			parsedURL, err := url.Parse(remoteURL)
-			if err != nil {
-				return nil, err
-			}
-			switch host := parsedURL.Hostname(); host {
-			case "github":
-				r.kind = RepoGitHub
-			case "gitlab":
-				r.kind = RepoGitLab
-			default:
-				if strings.Contains(host, "svn") {
-					r.kind = RepoSVN
-				} else {
-					r.kind = RepoGitOthers
-				}
-			}
-
-			r.url = fmt.Sprintf("%s://%s", parsedURL.Scheme, parsedURL.Hostname())
-
-			// ${r.url}/username/repository.git
-			re := regexp.MustCompile(fmt.Sprintf(`^%s[:/](.*?)/(.*?)(?:\.git)?$`, regexp.QuoteMeta(r.url)))
-			matches := re.FindStringSubmatch(remoteURL)
-
-			if len(matches) > 2 {
-				r.owner = matches[1]
-				r.name = matches[2]
-			} else {
-				panic("fail to parse repository's name and owner")
+	r.remote = pickRemote(remotes)
+	if r.remote == nil {
+		if abs, err := filepath.Abs(repoPath); err == nil {
+			if filepath.Base(abs) == ".git" {
+				abs = filepath.Dir(abs)
 			}
+			r.name = filepath.Base(abs)
 		}
+		r.kind = RepoGitOthers
+		r.forge = localForge{}
+		return r, nil
 	}
 
-	return r, nil
-}
-
-func (r *Repository) Owner() string {
-	return r.owner
-}
-
-func (r *Repository) Name() string {
-	return r.name
-}
-
-func (r *Repository) GenerateContributors(w io.Writer) error {
-	ref, err := r.repo.Head()
-	if err != nil {
-		return err
+	r.host = r.remote.Host
+	r.url = r.remote.WebURL()
+	r.owner = r.remote.Owner
+	r.name = r.remote.Repo
+	r.forge = NewForge(DetectForge(r.host), r.url, r.owner, r.name)
+
+	switch host := strings.ToLower(r.host); {
+	case strings.Contains(host, "github"):
+		r.kind = RepoGitHub
+	case strings.Contains(host, "gitlab"):
+		r.kind = RepoGitLab
+	case strings.Contains(host, "svn"):
+		r.kind = RepoSVN
+	default:
+		r.kind = RepoGitOthers
 	}
-
-	commitIter, err := r.repo.Log(&git.LogOptions{From: ref.Hash()})
-	if err != nil {
-		return err
-	}
-
-	authorCommits := make(map[string]int)
-	name2Email := make(map[string]string)
-
-	err = commitIter.ForEach(func(commit *object.Commit) error {
-		author := commit.Author.Name
-		authorCommits[author]++
-		name2Email[author] = commit.Author.Email
-		return nil
-	})
-	if err != nil {
-		return err
-	}
-
-	type authorStats struct {
-		Author string
-		Email  string
-		Count  int
-	}
-
-	var stats []authorStats
-	for author, count := range authorCommits {
-		stats = append(stats, authorStats{Author: author, Email: name2Email[author], Count: count})
-	}
-
-	sort.Slice(stats, func(i, j int) bool {
-		return stats[i].Count > stats[j].Count // Sort by number of commit in descending order
-	})
-
-	fmt.Fprintln(w, "# Contributors")
-	for _, s := range stats {
-		fmt.Fprintf(w, "- [%s](%s/%s)\n", s.Author, r.url, extractGitUsername(s.Email))
-	}
-
-	return nil
+	return r, nil
 }
 
-// Extract the Git username from the email address
-func extractGitUsername(email string) string {
-	// Find the index of '@'
-	atIndex := strings.Index(email, "@")
-	if atIndex == -1 {
-		// If '@' is not found, return an empty string
-		return ""
+// pickRemote returns the first remote of RemotePreference hosted by a forge,
+// then the other remotes by name. Local and unparsable remotes are skipped.
+func pickRemote(remotes []*git.Remote) *Remote {
+	byName := make(map[string]*git.Remote)
+	var names []string
+	for _, remote := range remotes {
+		byName[remote.Config().Name] = remote
+		names = append(names, remote.Config().Name)
 	}
+	sort.Strings(names)
+	names = append(append([]string{}, RemotePreference...), names...)
 
-	// Return the part before '@', which is the username
-	return email[:atIndex]
-}
-
-func (r *Repository) GenerateChangelog(w io.Writer) error {
-	tags := r.getSortedTags(r.repo)
-
-	if len(tags) == 0 {
-		ref, err := r.repo.Head()
-		if err != nil {
-			return err
+	for _, name := range names {
+		remote, ok := byName[name]
+		if !ok {
+			continue
 		}
-
-		commitIter, err := r.repo.Log(&git.LogOptions{From: ref.Hash()})
-		if err != nil {
-			return err
-		}
-
-		fmt.Fprintln(w, "# 📜 Changelog")
-		err = commitIter.ForEach(func(commit *object.Commit) error {
-			fmt.Fprintf(w, "- %s [%s]\n", r.formatCommitMessage(commit.Message), r.formatCommitHash(commit))
-			return nil
-		})
-
-		if err != nil {
-			return err
-		}
-	} else {
-		// range commit log
-		iter, err := r.repo.Log(&git.LogOptions{})
-		if err != nil {
-			return fmt.Errorf("failed to get commit log: %w", err)
-		}
-		defer iter.Close()
-
-		tagIndex := 0
-		tagMap := make(map[string][]*object.Commit)
-
-		err = iter.ForEach(func(commit *object.Commit) error {
-			// when a tag commit is encountered, switch to the next tag
-			if tagIndex < len(tags) && commit.Hash == tags[tagIndex].Commit.Hash {
-				tagIndex++
-			}
-			if tagIndex == 0 {
-				// haven't entered any tags yet
-				return nil
-			}
-
-			// categorized into the current tag
-			tagMap[tags[tagIndex-1].Name] = append(tagMap[tags[tagIndex-1].Name], commit)
-			return nil
-		})
-
-		if err != nil {
-			panic(err)
-		}
-
-		fmt.Fprintln(w, "# 📜 Changelog")
-		for _, tag := range tags {
-			fmt.Fprintf(w, "\n## %s\n", tag.Name)
-			for _, commit := range tagMap[tag.Name] {
-				fmt.Fprintf(w, "- %s [%s]\n", r.formatCommitMessage(commit.Message), r.formatCommitHash(commit))
+		for _, rawURL := range remote.Config().URLs {
+			parsed, err := ParseRemoteURL(rawURL)
+			if err != nil || parsed.Local {
+				continue
 			}
+			parsed.Name = name
+			return parsed
 		}
 	}
-
 	return nil
 }
 
-type TagInfo struct {
-	Name   string
-	Commit *object.Commit
+// Local reports whether the repository has no remote hosted by a forge.
+func (r *Repository) Local() bool {
+	return r.remote == nil
 }
 
-func (r *Repository) GetTags() []TagInfo {
-	return r.getSortedTags(r.repo)
+// Remote returns the remote describing the project, nil when the repository is local.
+func (r *Repository) Remote() *Remote {
+	return r.remote
 }
 
-// getSortedTags returns all tags and sort (by commit time)
-func (r *Repository) getSortedTags(repo *git.Repository) []TagInfo {
-	var tags []TagInfo
-
-	iter, err := repo.Tags()
-	if err != nil {
-		panic(err)
-	}
+// Kind returns the kind of the repository, guessed from the hostname of the remote.
+func (r *Repository) Kind() RepoKind {
+	return r.kind
+}
 
-	err = iter.ForEach(func(ref *plumbing.Reference) error {
-		tagObj, err := repo.TagObject(ref.Hash())
-		var commit *object.Commit
+func (r *Repository) Owner() string {
+	return r.owner
+}
 
-		if err == nil {
-			// Annotated tag，point at commit
-			commit, err = repo.CommitObject(tagObj.Target)
-		} else {
-			// Lightweight tag，directly point at commit
-			commit, err = repo.CommitObject(ref.Hash())
-		}
+func (r *Repository) Name() string {
+	return r.name
+}
 
-		if err == nil {
-			tags = append(tags, TagInfo{Name: ref.Name().Short(), Commit: commit})
-		}
-		return nil
-	})
+// Host returns the hostname of the remote, e.g. "github.com".
+func (r *Repository) Host() string {
+	return r.host
+}
 
-	if err != nil {
-		panic(err)
-	}
+// URL returns the base URL of the remote, e.g. "https://github.com".
+func (r *Repository) URL() string {
+	return r.url
+}
 
-	// Sort by commit time (latest first)
-	sort.Slice(tags, func(i, j int) bool {
-		return tags[i].Commit.Committer.When.After(tags[j].Commit.Committer.When)
-	})
+// Forge returns the forge hosting the remote, its URLs are empty
+// when the repository is local.
+func (r *Repository) Forge() Forge {
+	return r.forge
+}
 
-	return tags
+// Branch returns the short name of the checked out branch,
+// or the empty string when HEAD is detached or unborn.
+func (r *Repository) Branch() string {
+	ref, err := r.repo.Head()
+	if err != nil || !ref.Name().IsBranch() {
+		return ""
+	}
+	return ref.Name().Short()
 }
 
-func (r *Repository) formatCommitHash(commit *object.Commit) string {
-	return fmt.Sprintf("[%s](%s/%s/%s/commit/%s)",
-		commit.Hash.String()[:7],
-		r.url,
-		r.owner,
-		r.name,
-		commit.Hash.String(),
-	)
+// profileURL returns the forge profile of the identity, empty when its login is unknown.
+func (r *Repository) profileURL(id Identity) string {
+	if len(id.Login) == 0 {
+		return ""
+	}
+	return r.forge.UserURL(id.Login)
 }
 
 var (
@@ -305,14 +194,29 @@ var (
 // formatCommitMessage parse the repository's reference in the commit message
 func (r *Repository) formatCommitMessage(message string) string {
 	// parse Issue/PR（#23 / GH-23）
-	message = hashRegex.ReplaceAllString(message, fmt.Sprintf("[#$1](%s/%s/%s/issues/$1)", r.url, r.owner, r.name))
+	message = hashRegex.ReplaceAllStringFunc(message, func(ref string) string {
+		id := hashRegex.FindStringSubmatch(ref)[1]
+		issue := r.forge.IssueURL(id)
+		if len(issue) == 0 {
+			return ref
+		}
+		return fmt.Sprintf("[#%s](%s)", id, issue)
+	})
 
 	// parse @username
-	message = mentionRegex.ReplaceAllString(message, fmt.Sprintf("[@$1](%s/$1)", r.url))
+	message = mentionRegex.ReplaceAllStringFunc(message, func(mention string) string {
+		user := r.forge.UserURL(mention[1:])
+		if len(user) == 0 {
+			return mention
+		}
+		return fmt.Sprintf("[%s](%s)", mention, user)
+	})
 
-	if r.kind == RepoGitLab {
+	if r.forge.Kind() == ForgeGitLab {
 		// parse !23 format（GitLab Merge Request）
-		message = mergeRequestRegex.ReplaceAllString(message, fmt.Sprintf("[GitLab Merge Request #$1](%s/%s/%s/merge_requests/$1)", r.url, r.owner, r.name))
+		message = mergeRequestRegex.ReplaceAllStringFunc(message, func(ref string) string {
+			return fmt.Sprintf("[%s](%s)", ref, r.forge.PullRequestURL(ref[1:]))
+		})
 	}
 
 	if r.kind == RepoSVN {
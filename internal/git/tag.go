@@ -0,0 +1,124 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package git
+
+import (
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+type TagInfo struct {
+	Name   string
+	Commit *object.Commit
+
+	// Version is the semantic version of the tag, nil when the tag isn't
+	// a version. Monorepo prefixes like "api/" are ignored, so "api/v1.2.0"
+	// is version 1.2.0.
+	Version *semver.Version
+}
+
+// Prerelease reports whether the tag is a prerelease version, e.g. "v1.0.0-rc.1".
+func (t TagInfo) Prerelease() bool {
+	return t.Version != nil && len(t.Version.Prerelease()) != 0
+}
+
+// TagOptions filters the tags of the repository.
+type TagOptions struct {
+	// Pattern keeps the tags matching the glob, e.g. "api/v*" in a monorepo.
+	// Empty keeps all the tags.
+	Pattern string
+
+	// SkipPrereleases leaves prerelease tags out, their commits are
+	// then part of the next stable release.
+	SkipPrereleases bool
+}
+
+// GetTags returns the tags from the latest to the oldest version,
+// or nil when they can't be read.
+func (r *Repository) GetTags() []TagInfo {
+	tags, _ := r.Tags(TagOptions{})
+	return tags
+}
+
+// Tags returns the tags sorted from the latest to the oldest version.
+// Tags that aren't versions come after the versions, latest commit first.
+func (r *Repository) Tags(opts TagOptions) ([]TagInfo, error) {
+	var tags []TagInfo
+
+	iter, err := r.repo.Tags()
+	if err != nil {
+		return nil, err
+	}
+
+	err = iter.ForEach(func(ref *plumbing.Reference) error {
+		name := ref.Name().Short()
+		if len(opts.Pattern) != 0 {
+			if ok, _ := path.Match(opts.Pattern, name); !ok {
+				return nil
+			}
+		}
+
+		tagObj, err := r.repo.TagObject(ref.Hash())
+		var commit *object.Commit
+
+		if err == nil {
+			// Annotated tag，point at commit
+			commit, err = r.repo.CommitObject(tagObj.Target)
+		} else {
+			// Lightweight tag，directly point at commit
+			commit, err = r.repo.CommitObject(ref.Hash())
+		}
+		if err != nil {
+			// tags of trees or blobs don't belong to the history
+			return nil
+		}
+
+		tag := TagInfo{Name: name, Commit: commit, Version: tagVersion(name)}
+		if opts.SkipPrereleases && tag.Prerelease() {
+			return nil
+		}
+		tags = append(tags, tag)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(tags, func(i, j int) bool {
+		a, b := tags[i], tags[j]
+		switch {
+		case a.Version != nil && b.Version != nil:
+			if !a.Version.Equal(b.Version) {
+				return a.Version.GreaterThan(b.Version)
+			}
+		case a.Version != nil:
+			return true
+		case b.Version != nil:
+			return false
+		}
+		if !a.Commit.Committer.When.Equal(b.Commit.Committer.When) {
+			return a.Commit.Committer.When.After(b.Commit.Committer.When)
+		}
+		return a.Name > b.Name
+	})
+	return tags, nil
+}
+
+// tagVersion parses the version of a tag, ignoring the path-like
+// prefix of monorepo tags.
+func tagVersion(name string) *semver.Version {
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	v, err := semver.NewVersion(name)
+	if err != nil {
+		return nil
+	}
+	return v
+}
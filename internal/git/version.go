@@ -0,0 +1,132 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package git
+
+import (
+	"docwiz/internal/commit"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// NextVersionOptions customizes the computation of the next version.
+type NextVersionOptions struct {
+	// Tags selects the version tags, prerelease tags are only used
+	// to number the next prerelease.
+	Tags TagOptions
+
+	// Pre is the prerelease channel, e.g. "rc" gives "v1.2.0-rc.1".
+	// Empty computes a stable version.
+	Pre string
+}
+
+// NextVersion is the version the unreleased commits call for.
+type NextVersion struct {
+	// Current is the latest stable tag reachable from HEAD, nil when the
+	// project has never been released.
+	Current *TagInfo
+
+	// Version is the next version, equal to the current one
+	// when no commit calls for a release.
+	Version *semver.Version
+
+	// Tag is the name of the tag of Version, it keeps the prefix
+	// of the current tag (e.g. "v" or "api/v").
+	Tag string
+
+	Bump commit.Bump
+
+	// Commits counts the commits made since the current tag.
+	Commits int
+}
+
+// NextVersion applies the Conventional Commits bump rules to the commits
+// made since the latest stable tag.
+func (r *Repository) NextVersion(opts NextVersionOptions) (*NextVersion, error) {
+	tags, err := r.Tags(opts.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := r.repo.Head()
+	if err != nil {
+		return nil, err
+	}
+	head, err := r.repo.CommitObject(ref.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	h := newHistory(false)
+	ancestors, err := h.ancestors(head)
+	if err != nil {
+		return nil, err
+	}
+
+	next := &NextVersion{}
+	current := semver.MustParse("0.0.0")
+	prefix := "v"
+	if strings.HasSuffix(opts.Tags.Pattern, "*") {
+		prefix = strings.TrimSuffix(opts.Tags.Pattern, "*")
+	}
+	exclude := map[plumbing.Hash]struct{}{}
+	for _, tag := range tags {
+		if _, ok := ancestors[tag.Commit.Hash]; !ok || tag.Version == nil || tag.Prerelease() {
+			continue
+		}
+		next.Current = &tag
+		current = tag.Version
+		prefix = strings.TrimSuffix(tag.Name, strings.TrimPrefix(tag.Version.Original(), "v"))
+		if exclude, err = h.ancestors(tag.Commit); err != nil {
+			return nil, err
+		}
+		break
+	}
+
+	commits, err := h.between(head, exclude)
+	if err != nil {
+		return nil, err
+	}
+	next.Commits = len(commits)
+	for _, c := range commits {
+		if b := commit.BumpOf(parseMessage(c.Message)); b > next.Bump {
+			next.Bump = b
+		}
+	}
+
+	version := next.Bump.Apply(*current)
+	if next.Bump != commit.BumpNone && len(opts.Pre) != 0 {
+		// number the prerelease after the existing ones of the same version
+		n := 0
+		for _, tag := range tags {
+			if tag.Version == nil || !tag.Version.Equal(semver.New(version.Major(), version.Minor(), version.Patch(), tag.Version.Prerelease(), "")) {
+				continue
+			}
+			if num, ok := strings.CutPrefix(tag.Version.Prerelease(), opts.Pre+"."); ok {
+				if i, err := strconv.Atoi(num); err == nil && i > n {
+					n = i
+				}
+			}
+		}
+		if version, err = version.SetPrerelease(fmt.Sprintf("%s.%d", opts.Pre, n+1)); err != nil {
+			return nil, err
+		}
+	}
+	next.Version = &version
+	next.Tag = prefix + version.String()
+	return next, nil
+}
+
+// CreateTag tags HEAD with a lightweight tag.
+func (r *Repository) CreateTag(name string) error {
+	ref, err := r.repo.Head()
+	if err != nil {
+		return err
+	}
+	_, err = r.repo.CreateTag(name, ref.Hash(), nil)
+	return err
+}
@@ -0,0 +1,54 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package git
+
+import (
+	"sort"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+)
+
+// StagedPaths returns the sorted paths of the changes added to the index.
+func (r *Repository) StagedPaths() ([]string, error) {
+	wt, err := r.repo.Worktree()
+	if err != nil {
+		return nil, err
+	}
+	status, err := wt.Status()
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for path, s := range status {
+		if s.Staging != git.Unmodified && s.Staging != git.Untracked {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// Commit records the index with the message, the author is read from the
+// git configuration. It returns the hash of the new commit.
+func (r *Repository) Commit(message string) (string, error) {
+	wt, err := r.repo.Worktree()
+	if err != nil {
+		return "", err
+	}
+	hash, err := wt.Commit(message, &git.CommitOptions{})
+	if err != nil {
+		return "", err
+	}
+	return hash.String(), nil
+}
+
+// User returns the name and email of the committer from the git configuration.
+func (r *Repository) User() (string, string) {
+	conf, err := r.repo.ConfigScoped(config.GlobalScope)
+	if err != nil {
+		return "", ""
+	}
+	return conf.User.Name, conf.User.Email
+}
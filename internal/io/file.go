@@ -5,6 +5,7 @@ package io
 
 import (
 	"encoding/json"
+	"io/fs"
 	"os"
 )
 
@@ -19,22 +20,39 @@ func Exist(path string) (bool, error) {
 	return true, err
 }
 
+// SafeFile is an output written to a temporary file, Close moves it in
+// place and Rollback drops it.
 type SafeFile struct {
 	*os.File
 	filename string
+	options  WriteOptions
 	closed   bool
 }
 
+// NewSafeFile creates filename, it fails with os.ErrExist when the file exists.
 func NewSafeFile(filename string) (*SafeFile, error) {
-	if ok, err := Exist(filename); ok || err != nil {
-		return nil, os.ErrExist
+	return OpenSafeFile(filename, WriteOptions{Policy: OverwriteFail})
+}
+
+// OpenSafeFile opens the output filename, the policy of the options tells
+// what Close does when the file exists. OverwriteFail fails right away.
+func OpenSafeFile(filename string, opts WriteOptions) (*SafeFile, error) {
+	if opts.Policy == OverwriteFail {
+		if ok, err := Exist(filename); ok || err != nil {
+			return nil, &fs.PathError{Op: "create", Path: filename, Err: fs.ErrExist}
+		}
 	}
-	file, err := os.Create(filename)
+	file, err := createTemp(filename)
 	if err != nil {
 		return nil, err
 	}
 
-	return &SafeFile{filename: filename, File: file}, nil
+	return &SafeFile{filename: filename, File: file, options: opts}, nil
+}
+
+// Filename returns the path of the output.
+func (f *SafeFile) Filename() string {
+	return f.filename
 }
 
 func (f *SafeFile) Write(p []byte) (n int, err error) {
@@ -45,30 +63,26 @@ func (f *SafeFile) Write(p []byte) (n int, err error) {
 	return f.File.Write(p)
 }
 
+// Close moves the written content to the output.
 func (f *SafeFile) Close() error {
 	if f.closed {
 		return os.ErrClosed
 	}
+	f.closed = true
+	defer os.Remove(f.File.Name())
 
-	defer func() {
-		if r := recover(); r != nil {
-			f.Rollback()
-		}
-	}()
-
-	err := f.File.Close()
-	if err != nil {
+	if err := f.File.Close(); err != nil {
 		return err
 	}
-
-	f.closed = true
-	return nil
+	return commitFile(f.File.Name(), f.filename, f.options)
 }
 
+// Rollback drops the written content, the output is left untouched.
 func (f *SafeFile) Rollback() {
 	if !f.closed {
-		f.Close()
-		os.Remove(f.filename)
+		f.closed = true
+		f.File.Close()
+		os.Remove(f.File.Name())
 	}
 }
 
@@ -91,9 +105,19 @@ func WriteFileFrom(src, dst string) error {
 	if err != nil {
 		return err
 	}
-	err = os.WriteFile(dst, data, 0666)
+	return WriteFile(dst, data, WriteOptions{Policy: OverwriteForce})
+}
+
+// ReadText reads the whole file as a string.
+func ReadText(filename string) (string, error) {
+	data, err := os.ReadFile(filename)
 	if err != nil {
-		return err
+		return "", err
 	}
-	return nil
+	return string(data), nil
+}
+
+// WriteText replaces the whole file atomically.
+func WriteText(filename, content string) error {
+	return WriteFile(filename, []byte(content), WriteOptions{Policy: OverwriteForce})
 }
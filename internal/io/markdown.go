@@ -0,0 +1,160 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package io
+
+import (
+	"os"
+	"regexp"
+	"strings"
+)
+
+// MarkdownDocument is a markdown file split at its level 2 headings,
+// which lets generated sections be replaced without touching the
+// hand-edited ones.
+type MarkdownDocument struct {
+	// Preamble is the text before the first level 2 heading.
+	Preamble string
+
+	Sections []MarkdownSection
+
+	// References are the link reference definitions ending the
+	// document, e.g. "[v1.0.0]: https://...".
+	References []string
+}
+
+// MarkdownSection is a level 2 heading and the text up to the next one.
+type MarkdownSection struct {
+	Heading string
+	Body    string
+}
+
+var referenceRegex = regexp.MustCompile(`^\[[^\]]+\]:\s`)
+
+// ParseMarkdown splits doc at its level 2 headings, headings inside
+// fenced code blocks are ignored.
+func ParseMarkdown(doc string) *MarkdownDocument {
+	lines := strings.Split(strings.ReplaceAll(doc, "\r\n", "\n"), "\n")
+
+	// the trailing link reference definitions
+	end := len(lines)
+	var references []string
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := strings.TrimSpace(lines[i])
+		if len(line) == 0 {
+			continue
+		}
+		if !referenceRegex.MatchString(line) {
+			break
+		}
+		references = append([]string{line}, references...)
+		end = i
+	}
+	lines = lines[:end]
+
+	d := &MarkdownDocument{References: references}
+	var (
+		body    []string
+		current *MarkdownSection
+		fenced  bool
+	)
+	flush := func() {
+		text := strings.TrimRight(strings.Join(body, "\n"), " \n")
+		if current == nil {
+			d.Preamble = text
+		} else {
+			current.Body = text
+			d.Sections = append(d.Sections, *current)
+		}
+		body = nil
+	}
+	for _, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			fenced = !fenced
+		}
+		if !fenced && strings.HasPrefix(line, "## ") {
+			flush()
+			current = &MarkdownSection{Heading: line}
+			continue
+		}
+		body = append(body, line)
+	}
+	flush()
+	return d
+}
+
+// Reference returns the label of a link reference definition.
+func Reference(definition string) string {
+	label, _, _ := strings.Cut(definition, "]:")
+	return strings.TrimPrefix(label, "[")
+}
+
+// String renders the document back to markdown.
+func (d *MarkdownDocument) String() string {
+	var parts []string
+	if len(d.Preamble) != 0 {
+		parts = append(parts, d.Preamble)
+	}
+	for _, s := range d.Sections {
+		if len(s.Body) == 0 {
+			parts = append(parts, s.Heading)
+		} else {
+			parts = append(parts, s.Heading+"\n"+s.Body)
+		}
+	}
+	if len(d.References) != 0 {
+		parts = append(parts, strings.Join(d.References, "\n"))
+	}
+	return strings.Join(parts, "\n\n") + "\n"
+}
+
+// SpliceMarkdown inserts the level 2 sections of generated before the
+// sections of the file, the existing sections for which replace returns
+// true are dropped. The preamble and the other sections of the file are
+// kept as is, footer is moved to the end of the document.
+func SpliceMarkdown(filename, generated, footer string, replace func(heading string) bool) error {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	return WriteText(filename, SpliceMarkdownText(string(data), generated, footer, replace))
+}
+
+// SpliceMarkdownText is SpliceMarkdown on the content of the file.
+func SpliceMarkdownText(content, generated, footer string, replace func(heading string) bool) string {
+	if len(footer) != 0 {
+		content = strings.TrimSuffix(strings.TrimRight(content, "\n"), strings.TrimRight(footer, "\n"))
+	}
+	existing := ParseMarkdown(content)
+	fresh := ParseMarkdown(generated)
+
+	sections := fresh.Sections
+	for _, s := range existing.Sections {
+		if !replace(s.Heading) {
+			sections = append(sections, s)
+		}
+	}
+	existing.Sections = sections
+
+	references := fresh.References
+	defined := make(map[string]struct{})
+	for _, r := range references {
+		defined[Reference(r)] = struct{}{}
+	}
+	for _, r := range existing.References {
+		if _, ok := defined[Reference(r)]; !ok {
+			references = append(references, r)
+		}
+	}
+	existing.References = references
+
+	if len(existing.Preamble) == 0 {
+		existing.Preamble = fresh.Preamble
+	}
+
+	out := existing.String()
+	if len(footer) != 0 {
+		out += footer
+	}
+	return out
+}
@@ -0,0 +1,40 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package io
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMarkdown(t *testing.T) {
+	doc := ParseMarkdown("# Changelog\n\nintro\n\n## v2\n\n- b\n\n```\n## not a heading\n```\n\n## v1\n- a\n\n[v2]: https://x/v2\n[v1]: https://x/v1\n")
+	assert.Equal(t, "# Changelog\n\nintro", doc.Preamble)
+	assert.Len(t, doc.Sections, 2)
+	assert.Equal(t, "## v2", doc.Sections[0].Heading)
+	assert.Contains(t, doc.Sections[0].Body, "## not a heading")
+	assert.Equal(t, []string{"[v2]: https://x/v2", "[v1]: https://x/v1"}, doc.References)
+	assert.Equal(t, "v2", Reference(doc.References[0]))
+}
+
+func TestSpliceMarkdown(t *testing.T) {
+	const footer = "\n---\n\nfooter"
+	filename := filepath.Join(t.TempDir(), "CHANGELOG.md")
+	assert.NoError(t, os.WriteFile(filename, []byte("# Changelog\n\n## Unreleased\n- old\n\n## v1\n- edited\n\n[v1]: https://x/v1\n"+footer), 0644))
+
+	generated := "# Changelog\n\n## Unreleased\n- new\n\n## v2\n- b\n\n[Unreleased]: https://x/HEAD\n[v2]: https://x/v2\n"
+	err := SpliceMarkdown(filename, generated, footer, func(heading string) bool {
+		return strings.Contains(heading, "Unreleased")
+	})
+	assert.NoError(t, err)
+
+	data, err := os.ReadFile(filename)
+	assert.NoError(t, err)
+	assert.Equal(t, "# Changelog\n\n## Unreleased\n- new\n\n## v2\n- b\n\n## v1\n- edited\n\n"+
+		"[Unreleased]: https://x/HEAD\n[v2]: https://x/v2\n[v1]: https://x/v1\n"+footer, string(data))
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package io
+
+import (
+	"bytes"
+	goio "io"
+	"os"
+)
+
+// Stdout is the name of the output written to the standard output.
+const Stdout = "-"
+
+// Output is a generated document, Close publishes it and Rollback drops it.
+type Output interface {
+	goio.Writer
+	Close() error
+	Rollback()
+	Filename() string
+}
+
+var (
+	_ Output = (*SafeFile)(nil)
+	_ Output = (*Preview)(nil)
+)
+
+// Preview is an output printed instead of written, for the dry runs and
+// the "-" output. The document is buffered until Close, so that a failed
+// run prints nothing.
+type Preview struct {
+	bytes.Buffer
+	filename string
+	options  WriteOptions
+	w        goio.Writer
+	closed   bool
+}
+
+// NewPreview returns the output printing the document of filename to w,
+// as WriteFile would write it with opts.
+func NewPreview(w goio.Writer, filename string, opts WriteOptions) *Preview {
+	return &Preview{filename: filename, options: opts, w: w}
+}
+
+// Filename returns the path the document would be written to.
+func (p *Preview) Filename() string {
+	return p.filename
+}
+
+// Close prints the document, wrapped or merged like the written file.
+func (p *Preview) Close() error {
+	if p.closed {
+		return os.ErrClosed
+	}
+	p.closed = true
+	if p.filename == Stdout {
+		_, err := p.w.Write(p.Bytes())
+		return err
+	}
+	doc, err := Render(p.filename, p.String(), p.options)
+	if err != nil {
+		return err
+	}
+	_, err = goio.WriteString(p.w, doc)
+	return err
+}
+
+// Rollback drops the document.
+func (p *Preview) Rollback() {
+	p.closed = true
+}
@@ -0,0 +1,48 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package io
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreview(t *testing.T) {
+	var stdout bytes.Buffer
+	var output Output = NewPreview(&stdout, "SECURITY.md", WriteOptions{})
+	output.Write([]byte("# Security"))
+	assert.Empty(t, stdout.String(), "the document is printed on close")
+	assert.NoError(t, output.Close())
+	assert.Equal(t, "# Security", stdout.String())
+	assert.Equal(t, "SECURITY.md", output.Filename())
+
+	stdout.Reset()
+	output = NewPreview(&stdout, Stdout, WriteOptions{})
+	output.Write([]byte("half"))
+	output.Rollback()
+	assert.Empty(t, stdout.String())
+
+	// the preview shows the document as it would be written
+	filename := filepath.Join(t.TempDir(), "SECURITY.md")
+	region := MarkdownRegion("security")
+	assert.NoError(t, os.WriteFile(filename, []byte(region.Wrap("v1")+"hand\n"), 0644))
+	for _, policy := range []OverwritePolicy{OverwriteForce, OverwriteMerge} {
+		stdout.Reset()
+		opts := WriteOptions{Policy: policy, Region: region}
+		output = NewPreview(&stdout, filename, opts)
+		output.Write([]byte("v2\n"))
+		assert.NoError(t, output.Close())
+
+		written := filepath.Join(t.TempDir(), "SECURITY.md")
+		data, _ := os.ReadFile(filename)
+		assert.NoError(t, os.WriteFile(written, data, 0644))
+		assert.NoError(t, WriteFile(written, []byte("v2\n"), opts))
+		text, _ := ReadText(written)
+		assert.Equal(t, text, stdout.String(), policy)
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package io
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// OverwritePolicy decides what happens when the output of a generator
+// already exists.
+type OverwritePolicy string
+
+const (
+	// OverwriteFail refuses to touch the existing file.
+	OverwriteFail OverwritePolicy = "fail"
+	// OverwriteForce replaces the existing file.
+	OverwriteForce OverwritePolicy = "overwrite"
+	// OverwriteBackup copies the existing file to a timestamped .bak
+	// file before replacing it.
+	OverwriteBackup OverwritePolicy = "backup"
+	// OverwritePrompt shows the diff and replaces the file once confirmed.
+	OverwritePrompt OverwritePolicy = "prompt"
+	// OverwriteMerge only rewrites the docwiz region of the existing file,
+	// the text around it is kept.
+	OverwriteMerge OverwritePolicy = "merge"
+)
+
+// OverwritePolicies are the known policies.
+var OverwritePolicies = []OverwritePolicy{OverwriteFail, OverwriteForce, OverwriteBackup, OverwritePrompt, OverwriteMerge}
+
+// ParseOverwritePolicy returns the policy named s, the empty string is OverwriteFail.
+func ParseOverwritePolicy(s string) (OverwritePolicy, error) {
+	if len(s) == 0 {
+		return OverwriteFail, nil
+	}
+	for _, p := range OverwritePolicies {
+		if strings.EqualFold(s, string(p)) {
+			return p, nil
+		}
+	}
+	return "", fmt.Errorf("unknown overwrite policy %q, expected one of fail, overwrite, backup, prompt or merge", s)
+}
+
+var (
+	// ErrDeclined is returned when the user keeps the existing file.
+	ErrDeclined = errors.New("overwrite declined")
+	// ErrNoRegion is returned when the file to merge has no docwiz region.
+	ErrNoRegion = errors.New("no docwiz region to merge")
+)
+
+// WriteOptions tells how to write a file which may exist.
+type WriteOptions struct {
+	Policy OverwritePolicy
+
+	// Region wraps the written content with OverwriteMerge or when the
+	// existing file has it, OverwriteMerge only rewrites it in the existing
+	// file. The files without region can't be merged.
+	Region Region
+
+	// Confirm asks whether to replace filename, diff is the unified diff
+	// of the change. OverwritePrompt declines without it.
+	Confirm func(filename, diff string) (bool, error)
+}
+
+// WriteFile writes data to a temporary file next to filename, then moves
+// it in place following the policy, so that an interrupted run never
+// leaves a truncated file.
+func WriteFile(filename string, data []byte, opts WriteOptions) error {
+	if opts.Policy == OverwriteFail {
+		if ok, err := Exist(filename); ok || err != nil {
+			return &fs.PathError{Op: "write", Path: filename, Err: fs.ErrExist}
+		}
+	}
+	tmp, err := createTemp(filename)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return commitFile(tmp.Name(), filename, opts)
+}
+
+// createTemp creates the temporary file of filename in the same directory,
+// so that renaming it is atomic.
+func createTemp(filename string) (*os.File, error) {
+	return os.CreateTemp(filepath.Dir(filename), "."+filepath.Base(filename)+".*.tmp")
+}
+
+// Render returns the document written to filename for the generated
+// content. The region only wraps the content with the merge policy or
+// when filename already has it, and the merge policy only rewrites it
+// in the existing document.
+func Render(filename, content string, opts WriteOptions) (string, error) {
+	old, err := ReadText(filename)
+	exists := err == nil
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return "", err
+	}
+	r := opts.Region
+	merge := opts.Policy == OverwriteMerge
+	if len(r.Begin) == 0 {
+		if merge && exists {
+			return "", fmt.Errorf("%s: %w, the format has no comments to mark it", filename, ErrNoRegion)
+		}
+		return content, nil
+	}
+	_, marked := r.Find(old)
+	switch {
+	case merge && exists && !marked:
+		return "", fmt.Errorf("%s: %w, wrap the generated part with %q and %q", filename, ErrNoRegion, r.Begin, r.End)
+	case merge && exists:
+		return r.Replace(old, content, false), nil
+	case merge, marked:
+		return r.Wrap(content), nil
+	}
+	return content, nil
+}
+
+// commitFile moves the temporary file to filename following the policy,
+// the temporary file is left to the caller when nothing is moved.
+func commitFile(tmp, filename string, opts WriteOptions) error {
+	info, err := os.Stat(filename)
+	exists := err == nil
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	content, err := ReadText(tmp)
+	if err != nil {
+		return err
+	}
+	doc, err := Render(filename, content, opts)
+	if err != nil {
+		return err
+	}
+	if doc != content {
+		if err = os.WriteFile(tmp, []byte(doc), 0644); err != nil {
+			return err
+		}
+	}
+	if !exists {
+		return replaceFile(tmp, filename, 0644)
+	}
+
+	switch opts.Policy {
+	case OverwriteForce:
+	case OverwriteBackup:
+		if _, err := backup(filename, info.Mode().Perm()); err != nil {
+			return err
+		}
+	case OverwritePrompt, OverwriteMerge:
+		old, err := ReadText(filename)
+		if err != nil {
+			return err
+		}
+		if doc == old {
+			return nil
+		}
+		if opts.Policy == OverwritePrompt {
+			if opts.Confirm == nil {
+				return ErrDeclined
+			}
+			ok, err := opts.Confirm(filename, Diff(filename, old, doc))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return ErrDeclined
+			}
+		}
+	default:
+		return &fs.PathError{Op: "write", Path: filename, Err: fs.ErrExist}
+	}
+	return replaceFile(tmp, filename, info.Mode().Perm())
+}
+
+// replaceFile renames tmp to filename with the permissions.
+func replaceFile(tmp, filename string, perm fs.FileMode) error {
+	if err := os.Chmod(tmp, perm); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filename)
+}
+
+// backup copies filename to filename.<timestamp>.bak and returns the name
+// of the copy.
+func backup(filename string, perm fs.FileMode) (string, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return "", err
+	}
+	name := fmt.Sprintf("%s.%s.bak", filename, time.Now().Format("20060102-150405"))
+	if err = os.WriteFile(name, data, perm); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// Diff returns the unified diff between the existing content of filename
+// and the new one.
+func Diff(filename, old, content string) string {
+	diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(old),
+		B:        difflib.SplitLines(content),
+		FromFile: filename,
+		ToFile:   filename + " (generated)",
+		Context:  3,
+	})
+	return diff
+}
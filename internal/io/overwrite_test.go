@@ -0,0 +1,126 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package io
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverwritePolicies(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "SECURITY.md")
+	read := func() string {
+		data, err := os.ReadFile(filename)
+		assert.NoError(t, err)
+		return string(data)
+	}
+
+	assert.NoError(t, WriteFile(filename, []byte("v1\n"), WriteOptions{Policy: OverwriteFail}))
+	assert.Equal(t, "v1\n", read())
+	assert.ErrorIs(t, WriteFile(filename, []byte("v2\n"), WriteOptions{Policy: OverwriteFail}), fs.ErrExist)
+	assert.Equal(t, "v1\n", read())
+
+	assert.NoError(t, WriteFile(filename, []byte("v2\n"), WriteOptions{Policy: OverwriteForce}))
+	assert.Equal(t, "v2\n", read())
+
+	assert.NoError(t, WriteFile(filename, []byte("v3\n"), WriteOptions{Policy: OverwriteBackup}))
+	assert.Equal(t, "v3\n", read())
+	backups, _ := filepath.Glob(filename + ".*.bak")
+	assert.Len(t, backups, 1)
+	data, _ := os.ReadFile(backups[0])
+	assert.Equal(t, "v2\n", string(data))
+
+	var diff string
+	confirm := func(answer bool) func(string, string) (bool, error) {
+		return func(_, d string) (bool, error) {
+			diff = d
+			return answer, nil
+		}
+	}
+	err := WriteFile(filename, []byte("v4\n"), WriteOptions{Policy: OverwritePrompt, Confirm: confirm(false)})
+	assert.ErrorIs(t, err, ErrDeclined)
+	assert.Equal(t, "v3\n", read())
+	assert.Contains(t, diff, "-v3\n+v4\n")
+	assert.NoError(t, WriteFile(filename, []byte("v4\n"), WriteOptions{Policy: OverwritePrompt, Confirm: confirm(true)}))
+	assert.Equal(t, "v4\n", read())
+
+	region := MarkdownRegion("security")
+	opts := WriteOptions{Policy: OverwriteMerge, Region: region}
+	assert.ErrorIs(t, WriteFile(filename, []byte("v5\n"), opts), ErrNoRegion)
+	assert.ErrorIs(t, WriteFile(filename, []byte("v5\n"), WriteOptions{Policy: OverwriteMerge}), ErrNoRegion)
+	assert.NoError(t, os.WriteFile(filename, []byte("intro\n\n"+region.Wrap("v4")+"\noutro\n"), 0600))
+	assert.NoError(t, os.Chmod(filename, 0600))
+	assert.NoError(t, WriteFile(filename, []byte("v5\n"), opts))
+	assert.Equal(t, "intro\n\n"+region.Wrap("v5")+"\noutro\n", read())
+
+	// the permissions of the existing file are kept, no temporary file is left
+	info, _ := os.Stat(filename)
+	assert.Equal(t, fs.FileMode(0600), info.Mode().Perm())
+	tmp, _ := filepath.Glob(filepath.Join(dir, ".*.tmp"))
+	assert.Empty(t, tmp)
+}
+
+func TestWriteFileRegion(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "SECURITY.md")
+	region := MarkdownRegion("security")
+
+	// the new document is wrapped, so that a later merge finds the region
+	opts := WriteOptions{Policy: OverwriteMerge, Region: region}
+	assert.NoError(t, WriteFile(filename, []byte("v1\n"), opts))
+	text, _ := ReadText(filename)
+	assert.Equal(t, region.Wrap("v1"), text)
+
+	assert.NoError(t, os.WriteFile(filename, []byte(text+"\nwritten by hand\n"), 0644))
+	assert.NoError(t, WriteFile(filename, []byte("v2\n"), opts))
+	text, _ = ReadText(filename)
+	assert.Equal(t, region.Wrap("v2")+"\nwritten by hand\n", text)
+
+	assert.NoError(t, WriteFile(filename, []byte("v3\n"), WriteOptions{Policy: OverwriteForce, Region: region}))
+	text, _ = ReadText(filename)
+	assert.Equal(t, region.Wrap("v3"), text)
+
+	// without merge, only the documents already marked are wrapped
+	issue := filepath.Join(filepath.Dir(filename), "ISSUE.md")
+	assert.NoError(t, WriteFile(issue, []byte("---\nname: Bug\n---\nv1\n"), WriteOptions{Region: region}))
+	text, _ = ReadText(issue)
+	assert.Equal(t, "---\nname: Bug\n---\nv1\n", text)
+
+	assert.ErrorIs(t, WriteFile(issue, []byte("v2\n"), opts), ErrNoRegion)
+
+	// the front matter stays first
+	assert.NoError(t, os.Remove(issue))
+	assert.NoError(t, WriteFile(issue, []byte("---\nname: Bug\n---\nv2\n"), opts))
+	text, _ = ReadText(issue)
+	assert.Equal(t, "---\nname: Bug\n---\n"+region.Wrap("v2"), text)
+}
+
+func TestSafeFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "LICENSE")
+
+	f, err := NewSafeFile(filename)
+	assert.NoError(t, err)
+	f.Write([]byte("MIT"))
+	ok, _ := Exist(filename)
+	assert.False(t, ok, "the output is written on close")
+	assert.NoError(t, f.Close())
+	_, err = NewSafeFile(filename)
+	assert.ErrorIs(t, err, fs.ErrExist)
+
+	f, err = OpenSafeFile(filename, WriteOptions{Policy: OverwriteForce})
+	assert.NoError(t, err)
+	f.Write([]byte("Apache"))
+	f.Rollback()
+	text, _ := ReadText(filename)
+	assert.Equal(t, "MIT", text)
+
+	_, err = ParseOverwritePolicy("Backup")
+	assert.NoError(t, err)
+	_, err = ParseOverwritePolicy("replace")
+	assert.Error(t, err)
+}
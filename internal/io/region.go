@@ -0,0 +1,112 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package io
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Region is a part of a document maintained by docwiz, it's delimited
+// by two comment lines so that regenerating the document keeps the
+// text written around it.
+type Region struct {
+	Begin string
+	End   string
+}
+
+// MarkdownRegion returns the region delimited by HTML comments, e.g.
+// "<!-- docwiz:begin report -->".
+func MarkdownRegion(name string) Region {
+	return Region{
+		Begin: fmt.Sprintf("<!-- docwiz:begin %s -->", name),
+		End:   fmt.Sprintf("<!-- docwiz:end %s -->", name),
+	}
+}
+
+// HashRegion returns the region delimited by "#" comments, for the
+// files like CODEOWNERS or .gitignore.
+func HashRegion(name string) Region {
+	return Region{
+		Begin: fmt.Sprintf("# docwiz:begin %s", name),
+		End:   fmt.Sprintf("# docwiz:end %s", name),
+	}
+}
+
+// Wrap returns the content between the markers. The YAML front matter
+// or the doctype of content stays before the begin marker, where GitHub
+// and the browsers look for them.
+func (r Region) Wrap(content string) string {
+	preamble, body := splitPreamble(content)
+	return preamble + r.wrap(body)
+}
+
+func (r Region) wrap(body string) string {
+	return r.Begin + "\n" + strings.TrimRight(body, "\n") + "\n" + r.End + "\n"
+}
+
+// splitPreamble returns the YAML front matter or the doctype line which
+// starts content, and the rest of content.
+func splitPreamble(content string) (string, string) {
+	if strings.HasPrefix(content, "---\n") {
+		for i := len("---\n"); i < len(content); {
+			line, _, _ := strings.Cut(content[i:], "\n")
+			i += len(line) + 1
+			if strings.TrimRight(line, " \r") == "---" {
+				i = min(i, len(content))
+				return content[:i], content[i:]
+			}
+		}
+		return "", content
+	}
+	if len(content) >= len("<!doctype") && strings.EqualFold(content[:len("<!doctype")], "<!doctype") {
+		line, _, _ := strings.Cut(content, "\n")
+		i := min(len(line)+1, len(content))
+		return content[:i], content[i:]
+	}
+	return "", content
+}
+
+// Find returns the content of the region in doc.
+func (r Region) Find(doc string) (string, bool) {
+	begin, end, ok := r.bounds(doc)
+	if !ok {
+		return "", false
+	}
+	return strings.TrimPrefix(doc[begin+len(r.Begin):end], "\n"), true
+}
+
+// Replace returns doc with the new content of the region. A document
+// without the region gets it at the top, or at the bottom when atEnd
+// is set, the rest of the document is kept as is. The front matter or
+// the doctype of content is dropped when doc is kept, it has its own.
+func (r Region) Replace(doc, content string, atEnd bool) string {
+	begin, end, ok := r.bounds(doc)
+	_, body := splitPreamble(content)
+	if ok {
+		rest := strings.TrimPrefix(doc[end+len(r.End):], "\n")
+		return doc[:begin] + r.wrap(body) + rest
+	}
+	if len(strings.TrimSpace(doc)) == 0 {
+		return r.Wrap(content)
+	}
+	if atEnd {
+		return strings.TrimRight(doc, "\n") + "\n\n" + r.wrap(body)
+	}
+	preamble, rest := splitPreamble(doc)
+	return preamble + r.wrap(body) + "\n" + rest
+}
+
+// bounds returns the offsets of the markers, the begin marker must come first.
+func (r Region) bounds(doc string) (int, int, bool) {
+	begin := strings.Index(doc, r.Begin)
+	if begin < 0 {
+		return 0, 0, false
+	}
+	end := strings.Index(doc[begin:], r.End)
+	if end < 0 {
+		return 0, 0, false
+	}
+	return begin, begin + end, true
+}
@@ -0,0 +1,35 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package io
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegion(t *testing.T) {
+	r := HashRegion("codeowners")
+	assert.Equal(t, "# docwiz:begin codeowners\n* @alice\n# docwiz:end codeowners\n", r.Replace("", "* @alice\n", false))
+
+	doc := r.Replace("# manual\n/docs/ @bob\n", "* @alice", false)
+	assert.Equal(t, "# docwiz:begin codeowners\n* @alice\n# docwiz:end codeowners\n\n# manual\n/docs/ @bob\n", doc)
+	content, ok := r.Find(doc)
+	assert.True(t, ok)
+	assert.Equal(t, "* @alice\n", content)
+
+	doc = r.Replace(doc, "* @carol\n", false)
+	assert.Equal(t, "# docwiz:begin codeowners\n* @carol\n# docwiz:end codeowners\n\n# manual\n/docs/ @bob\n", doc)
+
+	m := MarkdownRegion("report")
+	assert.Equal(t, "# Report\n\nintro\n\n<!-- docwiz:begin report -->\n| a |\n<!-- docwiz:end report -->\n", m.Replace("# Report\n\nintro\n", "| a |", true))
+	_, ok = m.Find(doc)
+	assert.False(t, ok)
+
+	// the front matter and the doctype stay before the markers
+	assert.Equal(t, "---\nname: Bug\n---\n<!-- docwiz:begin report -->\nbody\n<!-- docwiz:end report -->\n", m.Wrap("---\nname: Bug\n---\nbody\n"))
+	html := m.Wrap("<!DOCTYPE html>\n<html></html>\n")
+	assert.Equal(t, "<!DOCTYPE html>\n<!-- docwiz:begin report -->\n<html></html>\n<!-- docwiz:end report -->\n", html)
+	assert.Equal(t, "<!DOCTYPE html>\n<!-- docwiz:begin report -->\n<p></p>\n<!-- docwiz:end report -->\nhand\n", m.Replace(html+"hand\n", "<!DOCTYPE html>\n<p></p>\n", true))
+}
@@ -4,6 +4,8 @@
 package template
 
 import (
+	"docwiz/internal/emoji"
+	"docwiz/internal/git"
 	"fmt"
 	"html/template"
 	"io"
@@ -65,6 +67,7 @@ func docwizFuncMap(tplPath string) template.FuncMap {
 	docwizFuncs["emojilizePrefix"] = emojilizePrefix
 	docwizFuncs["emojilizeSuffix"] = emojilizeSuffix
 	docwizFuncs["registerEmoji"] = registerEmoji
+	docwizFuncs["contributionEmoji"] = contributionEmoji
 
 	// version
 	docwizFuncs["randomVersion"] = randomVersion
@@ -142,31 +145,20 @@ type GitUrl struct {
 	Name  string
 }
 
+// parseGitURL parses the remote URL of a repository, the zero
+// GitUrl is returned when the URL isn't a remote.
 func parseGitURL(remoteURL string) GitUrl {
 	u := GitUrl{}
-
-	if strings.HasPrefix(remoteURL, "git@") {
-		remoteURL = strings.Replace(remoteURL, "git@", "https://", 1)
-		remoteURL = strings.Replace(remoteURL, ":", "/", 1)
+	remote, err := git.ParseRemoteURL(remoteURL)
+	if err != nil || remote.Local {
+		return u
 	}
 
-	parsedURL, err := url.Parse(remoteURL)
-	if err != nil {
-		panic(err)
-	}
-
-	host := fmt.Sprintf("%s://%s", parsedURL.Scheme, parsedURL.Hostname())
-
-	// ${r.url}/username/repository.git
-	re := regexp.MustCompile(fmt.Sprintf(`^%s[:/](.*?)/(.*?)(?:\.git)?$`, regexp.QuoteMeta(host)))
-	matches := re.FindStringSubmatch(remoteURL)
-
-	if len(matches) > 2 {
-		u.Owner = matches[1]
-		u.Name = matches[2]
-	} else {
-		panic("fail to parse")
+	if web, err := url.Parse(remote.WebURL()); err == nil {
+		u.URL = *web
 	}
+	u.Owner = remote.Owner
+	u.Name = remote.Repo
 	return u
 }
 
@@ -296,142 +288,59 @@ func versionIncPatch(v semver.Version) semver.Version {
 	return v.IncPatch()
 }
 
-var emojiMap = map[string]string{
-	// 🎨 Improve structure / format of the code.
-	"format":   "🎨",
-	"reformat": "🎨",
-
-	// ⚡ Improve performance.
-	"performance": "⚡",
-	"optimize":    "⚡",
-	"speed":       "⚡",
-
-	// 🔥 Remove code or files.
-	"remove":  "🔥",
-	"delete":  "🔥",
-	"cleanup": "🔥",
-
-	// 🐛 Fix a bug.
-	"fix":   "🐛",
-	"bug":   "🐛",
-	"error": "🐛",
-
-	// 🚑 Critical hotfix.
-	"hotfix": "🚑",
-	"patch":  "🚑",
-
-	// ✨ Introduce new features.
-	"feature": "✨",
-	"new":     "✨",
-	"add":     "✨",
-
-	// 📝 Add or update documentation.
-	"docs":     "📝",
-	"document": "📝",
-	"readme":   "📝",
-
-	// 🚀 Deploy stuff.
-	"deploy":  "🚀",
-	"release": "🚀",
-	"launch":  "🚀",
-
-	// 💄 Improve UI / UX.
-	"ui":     "💄",
-	"ux":     "💄",
-	"design": "💄",
-	"theme":  "💄",
-
-	// 🎉 Initial commit.
-	"init":    "🎉",
-	"initial": "🎉",
-	"first":   "🎉",
-
-	// ✅ Add tests.
-	"test":     "✅",
-	"unittest": "✅",
-
-	// 🔧 Configuration changes.
-	"config":   "🔧",
-	"settings": "🔧",
-	"env":      "🔧",
-
-	// 🔥 Remove dead code.
-	"deadcode": "🔥",
-	"unused":   "🔥",
-
-	// ♻️ Refactoring code.
-	"refactor": "♻️",
-	"rewrite":  "♻️",
-	"rework":   "♻️",
-
-	// 🚚 Move / rename files.
-	"rename": "🚚",
-	"move":   "🚚",
-
-	// 🔒 Fix security issues.
-	"security": "🔒",
-	"secure":   "🔒",
-
-	// ⚰️ Remove dead code.
-	"dead":   "⚰️",
-	"legacy": "⚰️",
-
-	// 🏗️ Work in progress.
-	"wip":  "🏗️",
-	"work": "🏗️",
-
-	// 🚧 Work in progress.
-	"inprogress": "🚧",
-	"progress":   "🚧",
-
-	// 💡 Add comments.
-	"comment": "💡",
-	"note":    "💡",
-
-	// 📦 Add or update dependencies.
-	"dependency": "📦",
-	"deps":       "📦",
-	"update":     "📦",
-
-	// 🔊 Add or update logs.
-	"log":    "🔊",
-	"logger": "🔊",
-	"debug":  "🔊",
-
-	// 🎭 Mocking data.
-	"mock": "🎭",
-	"fake": "🎭",
-
-	// 🚑 Critical hotfix.
-	"urgent":    "🚑",
-	"emergency": "🚑",
-
-	// 🛠️ Maintenance work.
-	"maintain":    "🛠️",
-	"maintenance": "🛠️",
-}
-
+// emojilizePrefix prefixes text with the emoji of its conventional type or its keywords.
 func emojilizePrefix(text string) string {
-	lowerText := strings.ToLower(text)
-	for key, emoji := range emojiMap {
-		if strings.Contains(lowerText, key) {
-			return emoji + " " + text
-		}
-	}
-	return text
+	return emoji.Default().Decorate(text, 1)
 }
 
+// emojilizeSuffix is emojilizePrefix with the emoji after text.
 func emojilizeSuffix(text string) string {
-	lowerText := strings.ToLower(text)
-	for key, emoji := range emojiMap {
-		if strings.Contains(lowerText, key) {
-			return text + " " + emoji
-		}
+	if emojis := emoji.Default().Emojis(text, 1); len(emojis) != 0 {
+		return text + " " + emojis[0]
 	}
 	return text
 }
 
-func registerEmoji(keyword, emoji string) string {
-	emojiMap[keyword] = emoji
+// registerEmoji adds a keyword to the emoji registry, e is an emoji or a gitmoji code.
+func registerEmoji(keyword, e string) string {
+	emoji.Default().AddKeyword(keyword, e, emoji.PriorityUser)
 	return ""
 }
+
+// contributionEmojis maps the all-contributors contribution types to their emoji.
+var contributionEmojis = map[string]string{
+	"code":              "💻",
+	"doc":               "📖",
+	"design":            "🎨",
+	"review":            "👀",
+	"bug":               "🐛",
+	"ideas":             "🤔",
+	"test":              "⚠️",
+	"infra":             "🚇",
+	"maintenance":       "🚧",
+	"translation":       "🌍",
+	"question":          "💬",
+	"content":           "🖋",
+	"example":           "💡",
+	"tool":              "🔧",
+	"financial":         "💵",
+	"talk":              "📢",
+	"tutorial":          "✅",
+	"video":             "📹",
+	"plugin":            "🔌",
+	"platform":          "📦",
+	"projectManagement": "📆",
+	"security":          "🛡️",
+	"research":          "🔬",
+	"data":              "🔣",
+	"mentoring":         "🧑‍🏫",
+}
+
+// contributionEmoji returns the emoji of an all-contributors contribution
+// type, unknown types are returned unchanged.
+func contributionEmoji(contribution string) string {
+	if e, ok := contributionEmojis[contribution]; ok {
+		return e
+	}
+	return contribution
+}
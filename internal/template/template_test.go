@@ -20,3 +20,11 @@ func TestInclude(t *testing.T) {
 		panic(err)
 	}
 }
+
+func TestContributionEmoji(t *testing.T) {
+	for contribution, expected := range map[string]string{"code": "💻", "projectManagement": "📆", "custom": "custom"} {
+		if got := contributionEmoji(contribution); got != expected {
+			t.Errorf("contributionEmoji(%q) = %q; want %q", contribution, got, expected)
+		}
+	}
+}
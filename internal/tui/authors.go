@@ -0,0 +1,116 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package tui
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/charmbracelet/huh"
+)
+
+// AuthorCandidate is a person proposed for the AUTHORS file.
+type AuthorCandidate struct {
+	Name string
+
+	// Summary explains the proposal, e.g. "12 commits, owns /docs".
+	Summary string
+
+	// Role is the duty written next to the name.
+	Role string
+
+	// Maintainer proposes the person as maintainer, the others are contributors.
+	Maintainer bool
+
+	// Excluded leaves the person out of the file.
+	Excluded bool
+}
+
+// AuthorsModel lets the user confirm the proposed maintainers and
+// contributors and edit the duties of the maintainers.
+type AuthorsModel struct {
+	form         *huh.Form
+	candidates   []AuthorCandidate
+	maintainers  []int
+	contributors []int
+	confirmed    bool
+}
+
+func NewAuthorsModel(candidates []AuthorCandidate) *AuthorsModel {
+	m := &AuthorsModel{candidates: slices.Clone(candidates), confirmed: true}
+
+	var maintainers, contributors []huh.Option[int]
+	for i, c := range m.candidates {
+		label := c.Name
+		if len(c.Summary) != 0 {
+			label = fmt.Sprintf("%s (%s)", c.Name, c.Summary)
+		}
+		maintainers = append(maintainers, huh.NewOption(label, i))
+		contributors = append(contributors, huh.NewOption(label, i))
+		switch {
+		case c.Excluded:
+		case c.Maintainer:
+			m.maintainers = append(m.maintainers, i)
+		default:
+			m.contributors = append(m.contributors, i)
+		}
+	}
+
+	groups := []*huh.Group{
+		huh.NewGroup(
+			huh.NewMultiSelect[int]().
+				Title("Maintainers").
+				Description("The people responsible for the project, proposed from CODEOWNERS and the history").
+				Options(maintainers...).
+				Filterable(true).
+				Value(&m.maintainers),
+		),
+		huh.NewGroup(
+			huh.NewMultiSelect[int]().
+				Title("Contributors").
+				Description("The other people to thank, the maintainers are left out of this list").
+				Options(contributors...).
+				Filterable(true).
+				Value(&m.contributors),
+		),
+	}
+	for i := range m.candidates {
+		groups = append(groups, huh.NewGroup(
+			huh.NewInput().
+				Title(m.candidates[i].Name).
+				Description("The role of the maintainer, e.g. Lead Developer").
+				Value(&m.candidates[i].Role),
+		).WithHideFunc(func() bool { return !slices.Contains(m.maintainers, i) }))
+	}
+	groups = append(groups, huh.NewGroup(
+		huh.NewConfirm().
+			Title("Save the authors?").
+			Affirmative("Save").
+			Negative("Cancel").
+			Value(&m.confirmed),
+	))
+
+	m.form = huh.NewForm(groups...)
+	return m
+}
+
+func (m *AuthorsModel) Run() error {
+	return m.form.Run()
+}
+
+// Confirmed reports whether the authors should be saved.
+func (m *AuthorsModel) Confirmed() bool {
+	return m.confirmed
+}
+
+// Value returns the candidates with the choices of the user, the people
+// selected as both maintainer and contributor are maintainers.
+func (m *AuthorsModel) Value() []AuthorCandidate {
+	candidates := slices.Clone(m.candidates)
+	for i := range candidates {
+		candidates[i].Maintainer = slices.Contains(m.maintainers, i)
+		candidates[i].Excluded = !candidates[i].Maintainer && !slices.Contains(m.contributors, i)
+	}
+	return candidates
+}
@@ -0,0 +1,216 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package tui
+
+import (
+	"docwiz/internal/commit"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/huh"
+)
+
+// commitTypeDescriptions describes the types of the Angular convention.
+var commitTypeDescriptions = map[string]string{
+	"feat":     "A new feature",
+	"fix":      "A bug fix",
+	"docs":     "Documentation only changes",
+	"style":    "Formatting, white-space, missing semi-colons...",
+	"refactor": "A change that neither fixes a bug nor adds a feature",
+	"perf":     "A change that improves performance",
+	"test":     "Adding or correcting tests",
+	"build":    "Changes to the build system or the dependencies",
+	"ci":       "Changes to the CI configuration",
+	"chore":    "Other changes that don't modify src or test files",
+	"revert":   "Reverts a previous commit",
+}
+
+type CommitModelConfigure struct {
+	// Types are the commit types to pick from.
+	Types []string
+
+	// Scopes are suggested for the scope, Restricted rejects the other scopes.
+	Scopes     []string
+	Restricted bool
+
+	// CoAuthors are the people to pick co-authors from, e.g. "Alice <alice@example.com>".
+	CoAuthors []string
+
+	// MaxSubjectLength limits the length of the subject, 0 means unlimited.
+	MaxSubjectLength int
+
+	// Decorate rewrites the message before the preview, e.g. to add emojis.
+	Decorate func(string) string
+}
+
+// commitDraft holds the answers of the form, its fields are exported
+// so that the preview notices their changes.
+type commitDraft struct {
+	Type         string
+	Scope        string
+	Subject      string
+	Body         string
+	Breaking     bool
+	BreakingNote string
+	Issues       string
+	CoAuthors    []string
+}
+
+// CommitModel composes a Conventional Commits message step by step.
+type CommitModel struct {
+	form      *huh.Form
+	draft     commitDraft
+	decorate  func(string) string
+	confirmed bool
+}
+
+func NewCommitModel(cfg CommitModelConfigure) *CommitModel {
+	m := &CommitModel{decorate: cfg.Decorate, confirmed: true}
+	if m.decorate == nil {
+		m.decorate = func(s string) string { return s }
+	}
+
+	var types []huh.Option[string]
+	for _, t := range cfg.Types {
+		label := t
+		if desc, ok := commitTypeDescriptions[t]; ok {
+			label = fmt.Sprintf("%-9s %s", t, desc)
+		}
+		types = append(types, huh.NewOption(label, t))
+	}
+
+	scopeDescription := "The part of the project the change touches (optional)"
+	if len(cfg.Scopes) != 0 {
+		scopeDescription = "Suggested: " + strings.Join(cfg.Scopes[:min(len(cfg.Scopes), 5)], ", ") + " (tab to complete)"
+	}
+
+	var coAuthors []huh.Option[string]
+	for _, person := range cfg.CoAuthors {
+		coAuthors = append(coAuthors, huh.NewOption(person, person))
+	}
+
+	groups := []*huh.Group{
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("Type").
+				Description("Select the type of change that you're committing").
+				Options(types...).
+				Value(&m.draft.Type),
+			huh.NewInput().
+				Title("Scope").
+				Description(scopeDescription).
+				Suggestions(cfg.Scopes).
+				Validate(func(s string) error {
+					if cfg.Restricted && len(s) != 0 && !slices.Contains(cfg.Scopes, s) {
+						return fmt.Errorf("the scope must be one of %s", strings.Join(cfg.Scopes, ", "))
+					}
+					return nil
+				}).
+				Value(&m.draft.Scope),
+		),
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Subject").
+				Description("A short, imperative description of the change").
+				Validate(func(s string) error {
+					n := utf8.RuneCountInString(strings.TrimSpace(s))
+					if n == 0 {
+						return fmt.Errorf("the subject is required")
+					}
+					if cfg.MaxSubjectLength > 0 && n > cfg.MaxSubjectLength {
+						return fmt.Errorf("the subject is %d characters long, the limit is %d", n, cfg.MaxSubjectLength)
+					}
+					return nil
+				}).
+				Value(&m.draft.Subject),
+			huh.NewText().
+				Title("Body").
+				Description("The motivation of the change and how it differs from the previous behavior (optional)").
+				Lines(5).
+				Value(&m.draft.Body),
+			huh.NewConfirm().
+				Title("Is this a breaking change?").
+				Affirmative("Yes").
+				Negative("No").
+				Value(&m.draft.Breaking),
+		),
+		huh.NewGroup(
+			huh.NewText().
+				Title("Breaking change").
+				Description("What breaks and how to migrate").
+				Lines(3).
+				Value(&m.draft.BreakingNote),
+		).WithHideFunc(func() bool { return !m.draft.Breaking }),
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Issues").
+				Description("The issues closed by the change, e.g. #12, #34 (optional)").
+				Value(&m.draft.Issues),
+		),
+	}
+	if len(coAuthors) != 0 {
+		groups = append(groups, huh.NewGroup(
+			huh.NewMultiSelect[string]().
+				Title("Co-authors").
+				Description("The people who worked on the change with you (optional)").
+				Options(coAuthors...).
+				Filterable(true).
+				Value(&m.draft.CoAuthors),
+		))
+	}
+	groups = append(groups, huh.NewGroup(
+		huh.NewNote().
+			Title("Preview").
+			DescriptionFunc(func() string {
+				return m.Value()
+			}, &m.draft),
+		huh.NewConfirm().
+			Title("Commit?").
+			Affirmative("Commit").
+			Negative("Cancel").
+			Value(&m.confirmed),
+	))
+
+	m.form = huh.NewForm(groups...)
+	return m
+}
+
+func (m *CommitModel) Run() error {
+	return m.form.Run()
+}
+
+// Confirmed reports whether the message should be committed.
+func (m *CommitModel) Confirmed() bool {
+	return m.confirmed
+}
+
+// Value returns the decorated message.
+func (m *CommitModel) Value() string {
+	d := m.draft
+	msg := commit.Message{
+		Type:     d.Type,
+		Scope:    strings.TrimSpace(d.Scope),
+		Subject:  strings.TrimSpace(d.Subject),
+		Body:     d.Body,
+		Breaking: d.Breaking,
+	}
+	if d.Breaking {
+		msg.BreakingNote = strings.TrimSpace(d.BreakingNote)
+	}
+	for _, issue := range strings.FieldsFunc(d.Issues, func(r rune) bool { return r == ',' || r == ' ' }) {
+		msg.Footers = append(msg.Footers, commit.Footer{Token: "Closes", Value: strings.TrimPrefix(issue, "#")})
+	}
+	for _, person := range d.CoAuthors {
+		msg.Footers = append(msg.Footers, commit.Footer{Token: "Co-authored-by", Value: person})
+	}
+
+	raw := msg.String()
+	header, rest, _ := strings.Cut(raw, "\n")
+	if rest = strings.TrimPrefix(rest, "\n"); len(rest) != 0 {
+		return m.decorate(header) + "\n\n" + rest
+	}
+	return m.decorate(header)
+}
@@ -0,0 +1,47 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package tui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	diffFile    = lipgloss.NewStyle().Bold(true).Render
+	diffAdded   = lipgloss.NewStyle().Foreground(lipgloss.Color("2")).Render
+	diffRemoved = lipgloss.NewStyle().Foreground(lipgloss.Color("1")).Render
+	diffHunk    = lipgloss.NewStyle().Foreground(lipgloss.Color("6")).Render
+)
+
+// ConfirmOverwrite prints the diff of filename to stderr and asks whether
+// to replace the file.
+func ConfirmOverwrite(filename, diff string) (bool, error) {
+	for _, line := range strings.Split(strings.TrimSuffix(diff, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			line = diffFile(line)
+		case strings.HasPrefix(line, "+"):
+			line = diffAdded(line)
+		case strings.HasPrefix(line, "-"):
+			line = diffRemoved(line)
+		case strings.HasPrefix(line, "@@"):
+			line = diffHunk(line)
+		}
+		fmt.Fprintln(os.Stderr, line)
+	}
+
+	var ok bool
+	err := huh.NewConfirm().
+		Title(fmt.Sprintf("Overwrite %s?", filename)).
+		Affirmative("Overwrite").
+		Negative("Keep").
+		Value(&ok).
+		Run()
+	return ok, err
+}
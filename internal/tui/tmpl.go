@@ -48,14 +48,14 @@ func (mod *TmplModel) Run() error {
 		case "input":
 			m := newTmplInputModel(q.Prompt, q.Placeholder)
 			if _, err := tea.NewProgram(m).Run(); err != nil {
-				panic(err)
+				return err
 			}
 			mod.data[q.Binding] = m.textInput.Value()
 			// TODO
 		case "confirm":
 			m := newTmplInputModel(q.Prompt, q.Placeholder)
 			if _, err := tea.NewProgram(m).Run(); err != nil {
-				panic(err)
+				return err
 			}
 			mod.data[q.Binding] = m.textInput.Value()
 		}
@@ -0,0 +1,326 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// Syntax identifies the version syntax of a package manager.
+type Syntax int
+
+const (
+	// SyntaxSemver is plain semantic versioning, optionally prefixed by "v" (Go modules).
+	SyntaxSemver Syntax = iota
+	// SyntaxNPM covers npm style ranges (^, ~, x-ranges, hyphen ranges and ||),
+	// also used by composer and pubspec.
+	SyntaxNPM
+	// SyntaxPEP440 covers Python specifiers (==, ~=, >=, comma separated clauses).
+	SyntaxPEP440
+	// SyntaxCargo covers Cargo requirements (bare versions are caret requirements).
+	SyntaxCargo
+	// SyntaxMaven covers Maven and NuGet versions and interval ranges.
+	SyntaxMaven
+)
+
+// Policy decides how much of a version is displayed on a badge.
+type Policy string
+
+const (
+	// PolicyExact displays the version with the precision it was written with.
+	PolicyExact Policy = "exact"
+	// PolicyMajorMinor displays major.minor.
+	PolicyMajorMinor Policy = "major.minor"
+	// PolicyMajor displays the major version only.
+	PolicyMajor Policy = "major"
+	// PolicyHide displays no version at all.
+	PolicyHide Policy = "hide"
+)
+
+// ParsePolicy converts s into a Policy, the empty string is PolicyExact.
+func ParsePolicy(s string) (Policy, error) {
+	switch p := Policy(strings.ToLower(strings.TrimSpace(s))); p {
+	case "":
+		return PolicyExact, nil
+	case PolicyExact, PolicyMajorMinor, PolicyMajor, PolicyHide:
+		return p, nil
+	}
+	return PolicyExact, fmt.Errorf("invalid version policy: %s", s)
+}
+
+// Version is a normalized version that remembers how many numeric
+// components the original string had, so "1.23" isn't displayed as "1.23.0".
+type Version struct {
+	*semver.Version
+	precision int
+}
+
+// Display formats the version according to the policy.
+func (v Version) Display(policy Policy) string {
+	switch policy {
+	case PolicyHide:
+		return ""
+	case PolicyMajor:
+		return fmt.Sprintf("%d", v.Major())
+	case PolicyMajorMinor:
+		if v.precision < 2 {
+			return fmt.Sprintf("%d", v.Major())
+		}
+		return fmt.Sprintf("%d.%d", v.Major(), v.Minor())
+	}
+
+	var s string
+	switch v.precision {
+	case 1:
+		s = fmt.Sprintf("%d", v.Major())
+	case 2:
+		s = fmt.Sprintf("%d.%d", v.Major(), v.Minor())
+	default:
+		s = fmt.Sprintf("%d.%d.%d", v.Major(), v.Minor(), v.Patch())
+	}
+	if pre := v.Prerelease(); len(pre) != 0 {
+		s += "-" + pre
+	}
+	return s
+}
+
+// Display normalizes raw and formats it according to the policy.
+// It returns the empty string when raw doesn't describe a version,
+// e.g. "*", "latest", a git URL or a workspace reference.
+func Display(raw string, syntax Syntax, policy Policy) string {
+	v, ok := Normalize(raw, syntax)
+	if !ok {
+		return ""
+	}
+	return v.Display(policy)
+}
+
+var (
+	// goPseudoRegex matches the suffix of Go pseudo-versions,
+	// e.g. "0.20250127205426-8255858bd2de" or "20250127205426-8255858bd2de".
+	goPseudoRegex = regexp.MustCompile(`^(?:0\.)?(?:[0-9A-Za-z-]+\.)?\d{14}-[0-9a-f]{12}$`)
+
+	// numericRegex extracts the leading dotted numbers and an optional
+	// prerelease of a version, wildcards (x, X, *) end the numbers.
+	numericRegex = regexp.MustCompile(`^(\d+)(?:\.(\d+|[xX*]))?(?:\.(\d+|[xX*]))?(?:[.-]?([0-9A-Za-z][0-9A-Za-z.-]*))?`)
+
+	// pep440PreRegex matches PEP 440 pre, post and dev release segments.
+	pep440PreRegex = regexp.MustCompile(`^(a|b|rc|c|alpha|beta|pre|preview|post|dev)[._-]?(\d*)`)
+)
+
+// Normalize extracts the version a requirement refers to. For ranges it
+// picks the lower bound, which is the version the project is known to
+// work with.
+func Normalize(raw string, syntax Syntax) (Version, bool) {
+	raw = strings.TrimSpace(raw)
+	if !looksLikeVersion(raw) {
+		return Version{}, false
+	}
+
+	switch syntax {
+	case SyntaxNPM:
+		return normalizeNPM(raw)
+	case SyntaxPEP440:
+		return normalizeClauses(raw, parsePEP440)
+	case SyntaxCargo:
+		return normalizeClauses(raw, parseSemverLike)
+	case SyntaxMaven:
+		return normalizeMaven(raw)
+	default:
+		return normalizeGo(raw)
+	}
+}
+
+// looksLikeVersion filters out values that can't be versions at all.
+func looksLikeVersion(raw string) bool {
+	if len(raw) == 0 {
+		return false
+	}
+	switch strings.ToLower(raw) {
+	case "*", "x", "latest", "next", "any", "workspace", "true", "false":
+		return false
+	}
+	for _, prefix := range []string{"{", "${", "git", "http:", "https:", "file:", "link:", "path:", "github:", "./", "../", "/"} {
+		if strings.HasPrefix(raw, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+func normalizeGo(raw string) (Version, bool) {
+	raw = strings.TrimSuffix(raw, "+incompatible")
+	v, ok := parseSemverLike(raw)
+	if !ok {
+		return v, false
+	}
+	// pseudo-versions refer to an untagged commit, the base version
+	// before the timestamp is the only meaningful part
+	if goPseudoRegex.MatchString(v.Prerelease()) {
+		stripped, err := v.SetPrerelease("")
+		if err != nil {
+			return v, false
+		}
+		v.Version = &stripped
+	}
+	return v, true
+}
+
+func normalizeNPM(raw string) (Version, bool) {
+	// npm aliases (npm:react@^18) and workspace protocol (workspace:^1.0.0)
+	if strings.HasPrefix(raw, "npm:") {
+		if i := strings.LastIndex(raw, "@"); i > 0 {
+			raw = raw[i+1:]
+		}
+	}
+	raw = strings.TrimPrefix(raw, "workspace:")
+
+	// the first alternative of "1.x || 2.x" is the oldest supported one
+	raw = strings.TrimSpace(strings.Split(raw, "||")[0])
+
+	// hyphen range "1.2.3 - 2.3.4"
+	if i := strings.Index(raw, " - "); i > 0 {
+		raw = raw[:i]
+	}
+
+	for _, clause := range strings.Fields(raw) {
+		if v, ok := lowerBound(clause, parseSemverLike); ok {
+			return v, true
+		}
+	}
+	return Version{}, false
+}
+
+// normalizeClauses handles comma separated requirements used by PEP 440 and Cargo.
+func normalizeClauses(raw string, parse func(string) (Version, bool)) (Version, bool) {
+	for _, clause := range strings.Split(raw, ",") {
+		if v, ok := lowerBound(strings.TrimSpace(clause), parse); ok {
+			return v, true
+		}
+	}
+	return Version{}, false
+}
+
+// lowerBound parses a single comparator, upper bounds and exclusions don't
+// tell which version is used and are skipped.
+func lowerBound(clause string, parse func(string) (Version, bool)) (Version, bool) {
+	if strings.HasPrefix(clause, "<") || strings.HasPrefix(clause, "!=") {
+		return Version{}, false
+	}
+	clause = strings.TrimLeft(clause, "^~=>! ")
+	return parse(clause)
+}
+
+func normalizeMaven(raw string) (Version, bool) {
+	// interval ranges: [1.0,2.0), (,1.0], [1.5]
+	if strings.HasPrefix(raw, "[") || strings.HasPrefix(raw, "(") {
+		bounds := strings.Split(strings.Trim(raw, "[]() "), ",")
+		for _, bound := range bounds {
+			if v, ok := parseMaven(strings.TrimSpace(bound)); ok {
+				return v, true
+			}
+		}
+		return Version{}, false
+	}
+	return parseMaven(raw)
+}
+
+func parseMaven(raw string) (Version, bool) {
+	// release qualifiers carry no information
+	for _, qualifier := range []string{".Final", "-Final", ".RELEASE", "-RELEASE", ".GA", "-GA"} {
+		raw = strings.TrimSuffix(raw, qualifier)
+	}
+	return parseSemverLike(raw)
+}
+
+func parsePEP440(raw string) (Version, bool) {
+	// drop the epoch ("1!2.0") and local version ("1.0+ubuntu1")
+	if i := strings.Index(raw, "!"); i >= 0 {
+		raw = raw[i+1:]
+	}
+	if i := strings.Index(raw, "+"); i >= 0 {
+		raw = raw[:i]
+	}
+	raw = strings.TrimSuffix(raw, ".*")
+
+	m := numericRegex.FindStringSubmatch(strings.TrimPrefix(raw, "v"))
+	if m == nil {
+		return Version{}, false
+	}
+
+	// PEP 440 allows more than three release components and post releases,
+	// only pre and dev releases are kept as semver prereleases
+	pre := ""
+	if pm := pep440PreRegex.FindStringSubmatch(m[4]); pm != nil {
+		switch pm[1] {
+		case "a", "alpha":
+			pre = "alpha"
+		case "b", "beta":
+			pre = "beta"
+		case "c", "rc", "pre", "preview":
+			pre = "rc"
+		case "dev":
+			pre = "dev"
+		}
+		if len(pre) != 0 && len(pm[2]) != 0 {
+			pre += "." + pm[2]
+		}
+	}
+	return build(m[1], m[2], m[3], pre)
+}
+
+func parseSemverLike(raw string) (Version, bool) {
+	raw = strings.TrimPrefix(strings.TrimPrefix(raw, "v"), "V")
+	if i := strings.Index(raw, "+"); i >= 0 {
+		raw = raw[:i]
+	}
+	m := numericRegex.FindStringSubmatch(raw)
+	if m == nil || len(m[0]) != len(raw) {
+		return Version{}, false
+	}
+	return build(m[1], m[2], m[3], m[4])
+}
+
+// build assembles a Version, wildcard components reduce the precision.
+func build(major, minor, patch, pre string) (Version, bool) {
+	precision := 1
+	parts := []string{major, "0", "0"}
+	if isNumber(minor) {
+		parts[1] = minor
+		precision = 2
+		if isNumber(patch) {
+			parts[2] = patch
+			precision = 3
+		}
+	}
+
+	s := strings.Join(parts, ".")
+	if len(pre) != 0 {
+		s += "-" + pre
+	}
+	v, err := semver.NewVersion(s)
+	if err != nil {
+		// the prerelease may contain characters semver doesn't accept
+		if v, err = semver.NewVersion(strings.Join(parts, ".")); err != nil {
+			return Version{}, false
+		}
+	}
+	return Version{Version: v, precision: precision}, true
+}
+
+func isNumber(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
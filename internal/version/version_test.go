@@ -0,0 +1,65 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisplay(t *testing.T) {
+	testCases := []struct {
+		raw      string
+		syntax   Syntax
+		policy   Policy
+		expected string
+	}{
+		{"^18.2.0", SyntaxNPM, PolicyExact, "18.2.0"},
+		{"~1.2.3", SyntaxNPM, PolicyMajorMinor, "1.2"},
+		{">=1.2.0 <2.0.0", SyntaxNPM, PolicyExact, "1.2.0"},
+		{"1.x || 2.x", SyntaxNPM, PolicyExact, "1"},
+		{"1.2.3 - 2.3.4", SyntaxNPM, PolicyMajor, "1"},
+		{"npm:react@^18.2.0", SyntaxNPM, PolicyExact, "18.2.0"},
+		{"workspace:^1.0.0", SyntaxNPM, PolicyExact, "1.0.0"},
+		{"latest", SyntaxNPM, PolicyExact, ""},
+		{"git+https://github.com/a/b.git", SyntaxNPM, PolicyExact, ""},
+		{">=3.8,<4", SyntaxPEP440, PolicyExact, "3.8"},
+		{"<4,>=3.8", SyntaxPEP440, PolicyExact, "3.8"},
+		{"~=1.4.2", SyntaxPEP440, PolicyExact, "1.4.2"},
+		{"==2.0rc1", SyntaxPEP440, PolicyExact, "2.0-rc.1"},
+		{"1!2.0.post1", SyntaxPEP440, PolicyExact, "2.0"},
+		{"^3.9", SyntaxPEP440, PolicyExact, "3.9"},
+		{"1.0.136", SyntaxCargo, PolicyExact, "1.0.136"},
+		{">=0.4, <0.6", SyntaxCargo, PolicyMajorMinor, "0.4"},
+		{"{workspace = true}", SyntaxCargo, PolicyExact, ""},
+		{"[1.0,2.0)", SyntaxMaven, PolicyExact, "1.0"},
+		{"(,1.5]", SyntaxMaven, PolicyExact, "1.5"},
+		{"5.3.21.RELEASE", SyntaxMaven, PolicyExact, "5.3.21"},
+		{"1.0-SNAPSHOT", SyntaxMaven, PolicyExact, "1.0-SNAPSHOT"},
+		{"${spring.version}", SyntaxMaven, PolicyExact, ""},
+		{"v1.9.1", SyntaxSemver, PolicyExact, "1.9.1"},
+		{"1.23", SyntaxSemver, PolicyExact, "1.23"},
+		{"v1.2.5-0.20241205214244-9306010a31ee", SyntaxSemver, PolicyExact, "1.2.5"},
+		{"v2.0.0+incompatible", SyntaxSemver, PolicyExact, "2.0.0"},
+		{"v1.9.1", SyntaxSemver, PolicyHide, ""},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, Display(tc.raw, tc.syntax, tc.policy), "Failed on input: %s", tc.raw)
+	}
+}
+
+func TestParsePolicy(t *testing.T) {
+	p, err := ParsePolicy("Major.Minor")
+	assert.NoError(t, err)
+	assert.Equal(t, PolicyMajorMinor, p)
+
+	p, err = ParsePolicy("")
+	assert.NoError(t, err)
+	assert.Equal(t, PolicyExact, p)
+
+	_, err = ParsePolicy("minor")
+	assert.Error(t, err)
+}
@@ -6,6 +6,7 @@ package dartwalk
 import (
 	"docwiz/internal/badge"
 	"docwiz/internal/cfg"
+	"docwiz/internal/version"
 	"docwiz/internal/walk"
 )
 
@@ -35,7 +36,7 @@ func (*Walker) ParseFile(fullpath string, file string, ctx *walk.Context) error
 
 	for _, env := range pubspec.Environments() {
 		if env.Name() == "sdk" {
-			ctx.Get("Dart").Badge.SetVersion(env.Version())
+			ctx.Get("Dart").Badge.SetVersion(ctx.Version("Dart", env.Version(), version.SyntaxNPM))
 		}
 	}
 
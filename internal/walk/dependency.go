@@ -5,6 +5,7 @@ package walk
 
 import (
 	"docwiz/internal/cfg"
+	"docwiz/internal/version"
 	"errors"
 	"strings"
 )
@@ -34,31 +35,44 @@ func (w *DependencyResolver) Match(name string) ExtendedBadge {
 	return nil
 }
 
-func ResolveDependency(ctx *Context, resolvers map[BadgeKind]*DependencyResolver, cfg cfg.Configure, tag string) error {
+func ResolveDependency(ctx *Context, resolvers map[BadgeKind]*DependencyResolver, conf cfg.Configure, tag string) error {
 	if resolver, ok := resolvers[ctx.StackBadgeKind()]; ok {
-		for _, dep := range cfg.ProjectDependencies() {
+		syntax := SyntaxOf(conf)
+		resolve := func(dep cfg.Dependency) {
 			eb := resolver.Match(dep.Name())
 			if eb != nil {
 				b := eb.Unwrap()
 				if eb.Kind() == ExtraInfoUseUseDependencyVersion {
-					b.SetVersion(dep.Version())
+					b.SetVersion(ctx.Version(b.Name(), dep.Version(), syntax))
 				}
 				ctx.Set(b.Name(), UpgradeBadge(tag, b))
 			}
 		}
 
-		for _, dep := range cfg.ProjectDevDependencies() {
-			eb := resolver.Match(dep.Name())
-			if eb != nil {
-				b := eb.Unwrap()
-				if eb.Kind() == ExtraInfoUseUseDependencyVersion {
-					b.SetVersion(dep.Version())
-				}
-				ctx.Set(b.Name(), UpgradeBadge(tag, b))
-			}
+		for _, dep := range conf.ProjectDependencies() {
+			resolve(dep)
+		}
+
+		for _, dep := range conf.ProjectDevDependencies() {
+			resolve(dep)
 		}
 		return nil
 	}
 
 	return errors.New("invalid resolver")
 }
+
+// SyntaxOf returns the version syntax used by the manifest.
+func SyntaxOf(conf cfg.Configure) version.Syntax {
+	switch conf.(type) {
+	case cfg.PackageJSON, cfg.Composer, cfg.PubSpec:
+		return version.SyntaxNPM
+	case cfg.Poetry:
+		return version.SyntaxPEP440
+	case cfg.CargoToml:
+		return version.SyntaxCargo
+	case cfg.POM, cfg.CSProj:
+		return version.SyntaxMaven
+	}
+	return version.SyntaxSemver
+}
@@ -22,7 +22,11 @@ func (*Walker) ParseDir(fullpath string, dir string, ctx *walk.Context) error {
 		return err
 	}
 
-	ctx.ProjectName = repo.Name()
-	ctx.ProjectOwner = repo.Owner()
+	ctx.Repository = repo
+	// local repositories don't know better than the manifests
+	if !repo.Local() {
+		ctx.ProjectName = repo.Name()
+		ctx.ProjectOwner = repo.Owner()
+	}
 	return nil
 }
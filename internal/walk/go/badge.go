@@ -11,8 +11,8 @@ var (
 		ID:        "Gin",
 		Label:     "Gin",
 		Color:     "#ffffff",
-		Logo:      "go",
-		LogoColor: "blue",
+		Logo:      "gin",
+		LogoColor: "#008ECF",
 		Href:      "https://github.com/gin-gonic/gin",
 	}
 	shieldBadgeFiber = &badge.ShieldBadge{
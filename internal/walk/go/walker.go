@@ -6,6 +6,7 @@ package gowalk
 import (
 	"docwiz/internal/badge"
 	"docwiz/internal/cfg"
+	"docwiz/internal/version"
 	"docwiz/internal/walk"
 )
 
@@ -36,7 +37,7 @@ func (*Walker) ParseFile(fullpath, file string, ctx *walk.Context) error {
 		}
 
 		if envs := mod.Environments(); len(envs) > 0 {
-			goBadge.Badge.SetVersion(envs[0].Version())
+			goBadge.Badge.SetVersion(ctx.Version("Go", envs[0].Version(), version.SyntaxSemver))
 		}
 
 		err = walk.ResolveDependency(ctx,
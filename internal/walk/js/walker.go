@@ -6,6 +6,7 @@ package jswalk
 import (
 	"docwiz/internal/badge"
 	"docwiz/internal/cfg"
+	"docwiz/internal/version"
 	"docwiz/internal/walk"
 )
 
@@ -44,7 +45,7 @@ func (*Walker) ParseFile(fullpath string, file string, ctx *walk.Context) error
 			} else if env.Name() == "NodeJS" {
 				b = badge.ShieldNodeJS
 			}
-			ctx.Set(env.Name(), walk.UpgradeBadge("JavaScript", b)).Badge.SetVersion(env.Version())
+			ctx.Set(env.Name(), walk.UpgradeBadge("JavaScript", b)).Badge.SetVersion(ctx.Version(env.Name(), env.Version(), version.SyntaxNPM))
 		}
 
 		return walk.ResolveDependency(ctx,
@@ -0,0 +1,206 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package walk
+
+import (
+	"bytes"
+	"docwiz/internal/badge"
+	"os"
+	"sort"
+)
+
+// declaredWeight is the score bonus for a technology declared in a manifest,
+// it outweighs a handful of stray files.
+const declaredWeight = 10
+
+// Evidence records how strongly a technology shows up in the project.
+type Evidence struct {
+	// Files is the number of files the technology was seen in.
+	Files int
+
+	// Lines is the number of lines of code across those files.
+	Lines int
+
+	// Declared reports whether the technology is declared by a manifest
+	// (go.mod, package.json, Cargo.toml, ...) or a dedicated directory.
+	Declared bool
+}
+
+// Score returns the significance of the evidence, higher is more significant.
+func (e Evidence) Score() float64 {
+	score := float64(e.Files) + float64(e.Lines)/100
+	if e.Declared {
+		score += declaredWeight
+	}
+	return score
+}
+
+// RankOptions defines the thresholds used to prune incidental technologies.
+// Zero values disable the corresponding threshold.
+type RankOptions struct {
+	// MaxBadges limits the number of badges kept after ranking.
+	MaxBadges int
+
+	// MinFiles is the minimum number of files for an undeclared technology.
+	MinFiles int
+
+	// MinLines is the minimum lines of code for an undeclared technology.
+	MinLines int
+
+	// MinShare is the minimum share (0-1) of the project's lines of code
+	// for an undeclared technology.
+	MinShare float64
+}
+
+// RankedBadge is a stack badge together with the evidence that produced it.
+type RankedBadge struct {
+	badge.SortableBadge
+	Evidence Evidence
+	Score    float64
+}
+
+// Evidence returns the evidence collected for the named badge.
+func (c *Context) Evidence(name string) Evidence {
+	if e, ok := c.evidence[name]; ok {
+		return *e
+	}
+	return Evidence{}
+}
+
+// Observe merges e into the evidence of the named badge. Walkers that know
+// more than the default bookkeeping of Set (e.g. a manifest listing several
+// source files) can use it to report additional evidence.
+func (c *Context) Observe(name string, e Evidence) {
+	if c.evidence == nil {
+		c.evidence = make(map[string]*Evidence)
+	}
+	old, ok := c.evidence[name]
+	if !ok {
+		old = &Evidence{}
+		c.evidence[name] = old
+	}
+	old.Files += e.Files
+	old.Lines += e.Lines
+	old.Declared = old.Declared || e.Declared
+}
+
+// ObservedFiles returns the files the extension walkers saw each
+// technology in, keyed by technology. The paths are absolute.
+func (c *Context) ObservedFiles() map[string][]string {
+	observed := make(map[string][]string)
+	for name, files := range c.observed {
+		for path := range files {
+			observed[name] = append(observed[name], path)
+		}
+		sort.Strings(observed[name])
+	}
+	return observed
+}
+
+// observe records the evidence implied by the handler currently running.
+func (c *Context) observe(name string) {
+	switch c.current.kind {
+	case handlerKindFile, handlerKindDir:
+		c.Observe(name, Evidence{Declared: true})
+	case handlerKindExt:
+		if c.observed == nil {
+			c.observed = make(map[string]map[string]struct{})
+		}
+		files, ok := c.observed[name]
+		if !ok {
+			files = make(map[string]struct{})
+			c.observed[name] = files
+		}
+		if _, ok := files[c.current.path]; ok {
+			return
+		}
+		files[c.current.path] = struct{}{}
+		c.Observe(name, Evidence{Files: 1, Lines: c.countLines(c.current.path)})
+	}
+}
+
+// countLines returns the number of lines of the file, results are cached
+// because several walkers may subscribe to the same file.
+func (c *Context) countLines(path string) int {
+	if n, ok := c.lines[path]; ok {
+		return n
+	}
+
+	n := 0
+	if data, err := os.ReadFile(path); err == nil && len(data) > 0 {
+		n = bytes.Count(data, []byte{'\n'})
+		if data[len(data)-1] != '\n' {
+			n++
+		}
+	}
+
+	if c.lines == nil {
+		c.lines = make(map[string]int)
+	}
+	c.lines[path] = n
+	return n
+}
+
+// Rank orders the stack badges by significance, drops the ignored and
+// incidental ones according to the context's RankOptions and truncates the
+// result to RankOptions.MaxBadges.
+func (c *Context) Rank() []RankedBadge {
+	total := 0
+	for name := range c.stack {
+		total += c.Evidence(name).Lines
+	}
+
+	var ranked []RankedBadge
+	for name, b := range c.stack {
+		if c.Ignore != nil {
+			if _, ok := c.Ignore.Badges[b.Name()]; ok {
+				continue
+			}
+		}
+		e := c.Evidence(name)
+		ranked = append(ranked, RankedBadge{SortableBadge: b, Evidence: e, Score: e.Score()})
+	}
+
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].Score != ranked[j].Score {
+			return ranked[i].Score > ranked[j].Score
+		}
+		if ranked[i].Tag != ranked[j].Tag {
+			return ranked[i].Tag < ranked[j].Tag
+		}
+		return ranked[i].Name() < ranked[j].Name()
+	})
+
+	kept := ranked[:0:0]
+	for _, r := range ranked {
+		if c.Ranking.significant(r.Evidence, total) {
+			kept = append(kept, r)
+		}
+	}
+	// never prune the stack down to nothing, the most significant
+	// technology is still the best guess about the project
+	if len(kept) == 0 && len(ranked) > 0 {
+		kept = ranked[:1]
+	}
+
+	if c.Ranking.MaxBadges > 0 && len(kept) > c.Ranking.MaxBadges {
+		kept = kept[:c.Ranking.MaxBadges]
+	}
+	return kept
+}
+
+// significant reports whether the evidence passes the thresholds,
+// technologies declared in a manifest always do.
+func (o RankOptions) significant(e Evidence, total int) bool {
+	if e.Declared {
+		return true
+	}
+	if e.Files < o.MinFiles || e.Lines < o.MinLines {
+		return false
+	}
+	if o.MinShare > 0 && total > 0 && float64(e.Lines)/float64(total) < o.MinShare {
+		return false
+	}
+	return true
+}
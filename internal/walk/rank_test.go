@@ -0,0 +1,79 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package walk_test
+
+import (
+	"docwiz/internal/walk"
+	gowalk "docwiz/internal/walk/go"
+	luawalk "docwiz/internal/walk/lua"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTree(t *testing.T, files map[string]string) string {
+	root := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	return root
+}
+
+func rankedNames(ranked []walk.RankedBadge) []string {
+	names := []string{}
+	for _, r := range ranked {
+		names = append(names, r.Name())
+	}
+	return names
+}
+
+func TestRankPrunesIncidental(t *testing.T) {
+	root := writeTree(t, map[string]string{
+		"go.mod":          "module example.com/demo\n\ngo 1.21\n",
+		"main.go":         strings.Repeat("// code\n", 200),
+		"pkg/util.go":     strings.Repeat("// code\n", 100),
+		"scripts/fix.lua": "print('hello')\n",
+	})
+
+	ctx := &walk.Context{
+		Walkers: []walk.Walker{&gowalk.Walker{}, &luawalk.Walker{}},
+		Ranking: walk.RankOptions{MinFiles: 2, MinShare: 0.05},
+	}
+	assert.NoError(t, walk.Walk(root, ctx))
+
+	goEvidence := ctx.Evidence("Go")
+	assert.True(t, goEvidence.Declared)
+	assert.Equal(t, 2, goEvidence.Files)
+	assert.Equal(t, 300, goEvidence.Lines)
+
+	luaEvidence := ctx.Evidence("Lua")
+	assert.False(t, luaEvidence.Declared)
+	assert.Equal(t, 1, luaEvidence.Files)
+
+	assert.Equal(t, []string{"Go"}, rankedNames(ctx.Rank()))
+}
+
+func TestRankOrderAndMax(t *testing.T) {
+	root := writeTree(t, map[string]string{
+		"a.lua": "print(1)\n",
+		"b.lua": "print(2)\n",
+		"c.go":  "package c\n",
+	})
+
+	ctx := &walk.Context{Walkers: []walk.Walker{&gowalk.Walker{}, &luawalk.Walker{}}}
+	assert.NoError(t, walk.Walk(root, ctx))
+	assert.Equal(t, []string{"Lua", "Go"}, rankedNames(ctx.Rank()))
+
+	ctx.Ranking.MaxBadges = 1
+	assert.Equal(t, []string{"Lua"}, rankedNames(ctx.Rank()))
+
+	// pruning everything still keeps the most significant technology
+	ctx.Ranking = walk.RankOptions{MinFiles: 10}
+	assert.Equal(t, []string{"Lua"}, rankedNames(ctx.Rank()))
+}
@@ -6,6 +6,7 @@ package rustwalk
 import (
 	"docwiz/internal/badge"
 	"docwiz/internal/cfg"
+	"docwiz/internal/version"
 	"docwiz/internal/walk"
 )
 
@@ -34,7 +35,7 @@ func (*Walker) ParseFile(fullpath string, file string, ctx *walk.Context) error
 	}
 
 	if envs := cargo.Environments(); len(envs) > 0 {
-		b.SetVersion(envs[0].Version())
+		b.SetVersion(ctx.Version("Rust", envs[0].Version(), version.SyntaxCargo))
 	}
 
 	return walk.ResolveDependency(ctx,
@@ -0,0 +1,57 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package statuswalk
+
+import (
+	"docwiz/internal/badge"
+	"strings"
+)
+
+// licenseMarkers maps SPDX identifiers to phrases found in the license text.
+// The order matters: more specific licenses come first.
+var licenseMarkers = []struct {
+	id      string
+	markers []string
+}{
+	{"AGPL-3.0", []string{"GNU AFFERO GENERAL PUBLIC LICENSE"}},
+	{"LGPL-3.0", []string{"GNU LESSER GENERAL PUBLIC LICENSE", "Version 3"}},
+	{"LGPL-2.1", []string{"GNU LESSER GENERAL PUBLIC LICENSE"}},
+	{"GPL-3.0", []string{"GNU GENERAL PUBLIC LICENSE", "Version 3"}},
+	{"GPL-2.0", []string{"GNU GENERAL PUBLIC LICENSE", "Version 2"}},
+	{"Apache-2.0", []string{"Apache License", "Version 2.0"}},
+	{"MPL-2.0", []string{"Mozilla Public License", "2.0"}},
+	{"EPL-2.0", []string{"Eclipse Public License", "2.0"}},
+	{"BSL-1.0", []string{"Boost Software License"}},
+	{"Unlicense", []string{"This is free and unencumbered software released into the public domain"}},
+	{"CC0-1.0", []string{"CC0 1.0 Universal"}},
+	{"WTFPL", []string{"DO WHAT THE FUCK YOU WANT TO PUBLIC LICENSE"}},
+	{"Zlib", []string{"This software is provided 'as-is'"}},
+	{"ISC", []string{"Permission to use, copy, modify, and/or distribute this software for any purpose"}},
+	{"BSD-3-Clause", []string{"Redistribution and use in source and binary forms", "Neither the name"}},
+	{"BSD-2-Clause", []string{"Redistribution and use in source and binary forms"}},
+	{"MIT", []string{"Permission is hereby granted, free of charge"}},
+}
+
+// detectLicense returns the SPDX identifier of the license text,
+// or the empty string if it isn't recognized.
+func detectLicense(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	upper := strings.ToUpper(text)
+outer:
+	for _, l := range licenseMarkers {
+		for _, marker := range l.markers {
+			if !strings.Contains(upper, strings.ToUpper(marker)) {
+				continue outer
+			}
+		}
+		return l.id
+	}
+	return ""
+}
+
+func licenseBadge(id, file string) badge.Badge {
+	b := &badge.ShieldBadge{ID: "License", Label: "license", Color: "blue", Href: "./" + file}
+	b.SetVersion(id)
+	return b
+}
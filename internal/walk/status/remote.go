@@ -0,0 +1,129 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package statuswalk
+
+import (
+	"docwiz/internal/badge"
+	"docwiz/internal/git"
+	"docwiz/internal/walk"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// remote holds the repository information the status badges are built from.
+type remote struct {
+	owner  string
+	name   string
+	host   string
+	url    string
+	forge  git.ForgeKind
+	branch string
+	tag    string
+}
+
+func newRemote(ctx *walk.Context) remote {
+	r := remote{owner: ctx.ProjectOwner, name: ctx.ProjectName}
+	if repo := ctx.Repository; repo != nil {
+		r.host = repo.Host()
+		r.url = repo.URL()
+		r.forge = repo.Forge().Kind()
+		r.branch = repo.Branch()
+		if tags := repo.GetTags(); len(tags) > 0 {
+			r.tag = tags[0].Name
+		}
+	}
+	return r
+}
+
+func (r remote) known() bool {
+	return len(r.owner) != 0 && len(r.name) != 0 && len(r.host) != 0
+}
+
+// github reports whether the repository lives on github.com,
+// shields.io can't query GitHub Enterprise instances.
+func (r remote) github() bool {
+	return r.known() && r.host == "github.com"
+}
+
+// gitlab reports whether the repository lives on gitlab.com
+// or on a self-hosted GitLab instance.
+func (r remote) gitlab() bool {
+	return r.known() && r.forge == git.ForgeGitLab
+}
+
+func (r remote) repoURL() string {
+	return fmt.Sprintf("%s/%s/%s", r.url, r.owner, r.name)
+}
+
+// gitlabQuery returns the query shared by the shields.io GitLab endpoints.
+func (r remote) gitlabQuery() url.Values {
+	query := url.Values{}
+	if r.host != "gitlab.com" {
+		query.Set("gitlab_url", r.url)
+	}
+	return query
+}
+
+func (r remote) workflowBadge(workflow string) badge.Badge {
+	if !r.github() {
+		return nil
+	}
+	label := strings.TrimSuffix(strings.TrimSuffix(workflow, ".yml"), ".yaml")
+	return badge.NewShieldsBadge(label,
+		fmt.Sprintf("github/actions/workflow/status/%s/%s/%s", r.owner, r.name, workflow),
+		url.Values{"label": {label}},
+		fmt.Sprintf("%s/actions/workflows/%s", r.repoURL(), workflow))
+}
+
+func (r remote) pipelineBadge() badge.Badge {
+	if !r.gitlab() {
+		return nil
+	}
+	query := r.gitlabQuery()
+	if len(r.branch) != 0 {
+		query.Set("branch", r.branch)
+	}
+	return badge.NewShieldsBadge("Pipeline",
+		"gitlab/pipeline-status/"+url.PathEscape(r.owner+"/"+r.name),
+		query, r.repoURL()+"/-/pipelines")
+}
+
+func (r remote) codecovBadge() badge.Badge {
+	// shields.io names the services, codecov.io abbreviates them
+	var service, short string
+	switch {
+	case r.github():
+		service, short = "github", "gh"
+	case r.gitlab() && r.host == "gitlab.com":
+		service, short = "gitlab", "gl"
+	default:
+		return nil
+	}
+	return badge.NewShieldsBadge("Coverage",
+		fmt.Sprintf("codecov/c/%s/%s/%s", service, r.owner, r.name), nil,
+		fmt.Sprintf("https://codecov.io/%s/%s/%s", short, r.owner, r.name))
+}
+
+func (r remote) releaseBadge() badge.Badge {
+	switch {
+	case r.github():
+		return badge.NewShieldsBadge("Release",
+			fmt.Sprintf("github/v/release/%s/%s", r.owner, r.name), nil,
+			r.repoURL()+"/releases")
+	case r.gitlab():
+		return badge.NewShieldsBadge("Release",
+			"gitlab/v/release/"+url.PathEscape(r.owner+"/"+r.name),
+			r.gitlabQuery(), r.repoURL()+"/-/releases")
+	case len(r.tag) != 0:
+		// without a supported forge, the latest tag is the best we can know
+		b := &badge.ShieldBadge{ID: "Release", Label: "release", Color: "blue"}
+		b.SetVersion(r.tag)
+		if r.known() {
+			b.Href = r.repoURL()
+		}
+		return b
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package statuswalk
+
+import (
+	"docwiz/internal/badge"
+	"docwiz/internal/cfg"
+	"docwiz/internal/walk"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Walker collects the signals needed by the status badges (CI configuration,
+// license, package manifests) and turns them into badges once the whole
+// project has been walked, when the git repository is known.
+type Walker struct {
+	walk.BaseWalker
+
+	workflows []string
+	gitlabCI  bool
+	codecov   bool
+	license   string
+	licenseOf string
+
+	// manifests maps a manifest name to its shallowest path,
+	// nested manifests usually belong to examples or vendored code.
+	manifests map[string]string
+}
+
+func (*Walker) SubscribeExt() []string {
+	return []string{".yml", ".yaml"}
+}
+
+func (*Walker) SubscribeFile() []string {
+	return []string{
+		".gitlab-ci.yml", "codecov.yml", ".codecov.yml",
+		"LICENSE", "LICENSE.md", "LICENSE.txt", "COPYING",
+		"go.mod", "package.json", "pyproject.toml", "Cargo.toml",
+	}
+}
+
+func (w *Walker) ParseExt(fullpath string, ext string, ctx *walk.Context) error {
+	dir := filepath.Dir(fullpath)
+	if filepath.Base(dir) == "workflows" && filepath.Base(filepath.Dir(dir)) == ".github" {
+		w.workflows = append(w.workflows, filepath.Base(fullpath))
+	}
+	return nil
+}
+
+func (w *Walker) ParseFile(fullpath string, file string, ctx *walk.Context) error {
+	switch file {
+	case ".gitlab-ci.yml":
+		w.gitlabCI = true
+	case "codecov.yml", ".codecov.yml":
+		w.codecov = true
+	case "LICENSE", "LICENSE.md", "LICENSE.txt", "COPYING":
+		if len(w.license) != 0 {
+			return nil
+		}
+		data, err := os.ReadFile(fullpath)
+		if err != nil {
+			return err
+		}
+		w.license = detectLicense(string(data))
+		w.licenseOf = file
+	default:
+		if w.manifests == nil {
+			w.manifests = make(map[string]string)
+		}
+		if old, ok := w.manifests[file]; !ok || depth(fullpath) < depth(old) {
+			w.manifests[file] = fullpath
+		}
+	}
+	return nil
+}
+
+func depth(path string) int {
+	return strings.Count(filepath.ToSlash(path), "/")
+}
+
+// Finish emits the status badges in a fixed order: build, coverage,
+// release, license, code quality and documentation, package registries.
+func (w *Walker) Finish(ctx *walk.Context) error {
+	r := newRemote(ctx)
+
+	sort.Strings(w.workflows)
+	for _, workflow := range w.workflows {
+		if b := r.workflowBadge(workflow); b != nil {
+			ctx.SetStatus("CI "+workflow, walk.UpgradeBadge("CI", b))
+		}
+	}
+	if w.gitlabCI {
+		if b := r.pipelineBadge(); b != nil {
+			ctx.SetStatus("Pipeline", walk.UpgradeBadge("CI", b))
+		}
+	}
+
+	if w.codecov {
+		if b := r.codecovBadge(); b != nil {
+			ctx.SetStatus("Coverage", walk.UpgradeBadge("Coverage", b))
+		}
+	}
+
+	if b := r.releaseBadge(); b != nil {
+		ctx.SetStatus("Release", walk.UpgradeBadge("Release", b))
+	}
+
+	if len(w.license) != 0 {
+		ctx.SetStatus("License", walk.UpgradeBadge("License", licenseBadge(w.license, w.licenseOf)))
+	}
+
+	if path, ok := w.manifests["go.mod"]; ok {
+		// only modules with a domain (github.com/...) can be fetched by the services
+		if mod, err := cfg.LoadGoModFromFile(path); err == nil && strings.Contains(strings.Split(mod.ProjectName(), "/")[0], ".") {
+			module := mod.ProjectName()
+			ctx.SetStatus("Go Report Card", walk.UpgradeBadge("Quality", &badge.DynamicBadge{
+				ID:    "Go Report Card",
+				Image: "https://goreportcard.com/badge/" + module,
+				Href:  "https://goreportcard.com/report/" + module,
+			}))
+			ctx.SetStatus("Go Reference", walk.UpgradeBadge("Docs", &badge.DynamicBadge{
+				ID:    "Go Reference",
+				Image: "https://pkg.go.dev/badge/" + module + ".svg",
+				Href:  "https://pkg.go.dev/" + module,
+			}))
+		}
+	}
+
+	if path, ok := w.manifests["package.json"]; ok {
+		if pkg, err := cfg.LoadPackageJSONFromFile(path); err == nil && len(pkg.ProjectName()) != 0 {
+			name := pkg.ProjectName()
+			ctx.SetStatus("npm", walk.UpgradeBadge("Package", badge.NewShieldsBadge(
+				"npm", "npm/v/"+name, nil, "https://www.npmjs.com/package/"+name)))
+		}
+	}
+
+	if path, ok := w.manifests["pyproject.toml"]; ok {
+		if poetry, err := cfg.LoadPoetryFromFile(path); err == nil && len(poetry.ProjectName()) != 0 {
+			name := poetry.ProjectName()
+			ctx.SetStatus("PyPI", walk.UpgradeBadge("Package", badge.NewShieldsBadge(
+				"PyPI", "pypi/v/"+name, nil, "https://pypi.org/project/"+name+"/")))
+		}
+	}
+
+	if path, ok := w.manifests["Cargo.toml"]; ok {
+		if cargo, err := cfg.LoadCargoFromFile(path); err == nil && len(cargo.ProjectName()) != 0 {
+			name := cargo.ProjectName()
+			ctx.SetStatus("crates.io", walk.UpgradeBadge("Package", badge.NewShieldsBadge(
+				"crates.io", "crates/v/"+name, nil, "https://crates.io/crates/"+name)))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package statuswalk
+
+import (
+	"docwiz/internal/badge"
+	"docwiz/internal/git"
+	"docwiz/internal/walk"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectLicense(t *testing.T) {
+	testCases := []struct {
+		text     string
+		expected string
+	}{
+		{"MIT License\n\nPermission is hereby granted, free of charge, to any person", "MIT"},
+		{"Apache License\n                           Version 2.0, January 2004", "Apache-2.0"},
+		{"GNU GENERAL PUBLIC LICENSE\n Version 3, 29 June 2007", "GPL-3.0"},
+		{"GNU LESSER GENERAL PUBLIC LICENSE\n Version 3, 29 June 2007", "LGPL-3.0"},
+		{"Redistribution and use in source and binary forms ... Neither the name of", "BSD-3-Clause"},
+		{"All rights reserved.", ""},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, detectLicense(tc.text), "Failed on input: %s", tc.text)
+	}
+}
+
+func TestFinish(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		"go.mod":                   "module github.com/example/project\n\ngo 1.21\n",
+		"LICENSE":                  "MIT License\n\nPermission is hereby granted, free of charge",
+		".github/workflows/ci.yml": "name: ci\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	ctx := &walk.Context{Walkers: []walk.Walker{&Walker{}}}
+	assert.NoError(t, walk.Walk(root, ctx))
+
+	// without a remote only the badges derived from files are emitted
+	assert.Equal(t, "[![License](https://img.shields.io/badge/license-MIT-blue.svg?style=for-the-badge)](./LICENSE) "+
+		"[![Go Report Card](https://goreportcard.com/badge/github.com/example/project)](https://goreportcard.com/report/github.com/example/project) "+
+		"[![Go Reference](https://pkg.go.dev/badge/github.com/example/project.svg)](https://pkg.go.dev/github.com/example/project)",
+		ctx.ProjectStatistics)
+}
+
+func TestCodecovBadge(t *testing.T) {
+	testCases := []struct {
+		remote remote
+		href   string
+	}{
+		{remote{owner: "acme", name: "widget", host: "github.com", forge: git.ForgeGitHub}, "https://codecov.io/gh/acme/widget"},
+		{remote{owner: "acme", name: "widget", host: "gitlab.com", forge: git.ForgeGitLab}, "https://codecov.io/gl/acme/widget"},
+	}
+	for _, tc := range testCases {
+		b, ok := tc.remote.codecovBadge().(*badge.DynamicBadge)
+		assert.True(t, ok)
+		assert.Equal(t, tc.href, b.Href)
+	}
+
+	assert.Nil(t, remote{owner: "acme", name: "widget", host: "git.example.com", forge: git.ForgeGitLab}.codecovBadge())
+}
@@ -0,0 +1,29 @@
+// Copyright 2025 The DocWiz Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package walk
+
+import "docwiz/internal/version"
+
+// VersionOptions decides how the versions found in manifests are displayed.
+type VersionOptions struct {
+	// Default is the policy of the badges missing from Badges,
+	// the empty string is version.PolicyExact.
+	Default version.Policy
+
+	// Badges overrides the policy per badge name.
+	Badges map[string]version.Policy
+}
+
+// Version normalizes the raw version of the named badge and formats it
+// according to the configured policy, e.g. "^18.2.0" becomes "18.2.0" or "18".
+func (c *Context) Version(name, raw string, syntax version.Syntax) string {
+	policy, ok := c.Versions.Badges[name]
+	if !ok {
+		policy = c.Versions.Default
+	}
+	if len(policy) == 0 {
+		policy = version.PolicyExact
+	}
+	return version.Display(raw, syntax, policy)
+}
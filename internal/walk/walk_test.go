@@ -4,9 +4,13 @@
 package walk_test
 
 import (
+	"docwiz/internal/badge"
 	"docwiz/internal/walk"
 	gowalk "docwiz/internal/walk/go"
+	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestWalk(t *testing.T) {
@@ -16,3 +20,19 @@ func TestWalk(t *testing.T) {
 		},
 	})
 }
+
+func TestBadgeSeparator(t *testing.T) {
+	a := &badge.ShieldBadge{ID: "A", Label: "A", Color: "blue"}
+	b := &badge.ShieldBadge{ID: "B", Label: "B", Color: "red"}
+	for format, sep := range map[badge.Format]string{
+		badge.FormatMarkdown: " ",
+		badge.FormatHTML:     "\n",
+		badge.FormatAsciiDoc: "\n",
+		badge.FormatRST:      "\n\n",
+	} {
+		ctx := &walk.Context{Extra: []badge.Badge{a, b}, BadgeFormat: format}
+		assert.NoError(t, walk.Walk(t.TempDir(), ctx))
+		expected := strings.TrimSpace(badge.Render(a, format)) + sep + strings.TrimSpace(badge.Render(b, format))
+		assert.Equal(t, expected, ctx.ProjectStack, format)
+	}
+}
@@ -6,9 +6,9 @@ package walk
 import (
 	"docwiz/internal/badge"
 	"docwiz/internal/cfg"
+	"docwiz/internal/git"
 	"io/fs"
 	"path/filepath"
-	"sort"
 	"strings"
 )
 
@@ -22,8 +22,24 @@ type Walker interface {
 	ParseDir(fullpath, dir string, ctx *Context) error
 }
 
+// Finisher is implemented by walkers that need the whole tree to be
+// walked before they can produce badges, Finish is called once after
+// the walk and before the badges are rendered.
+type Finisher interface {
+	Finish(ctx *Context) error
+}
+
 type parseHandler func(string, string, *Context) error
 
+type handlerKind int
+
+const (
+	handlerKindNone handlerKind = iota
+	handlerKindExt
+	handlerKindFile
+	handlerKindDir
+)
+
 type BaseWalker struct{}
 
 func (BaseWalker) SubscribeExt() []string  { return nil }
@@ -45,13 +61,47 @@ type Context struct {
 	ProjectOwner       string
 	ProjectDescription string
 	ProjectStack       string
+	ProjectStatistics  string
+
+	// Repository is the git repository of the project, it's nil
+	// until the .git directory has been visited.
+	Repository *git.Repository
 
 	stackKind BadgeKind
 	stack     map[string]badge.SortableBadge
 
 	statisticsKind BadgeKind
 	statistics     map[string]badge.SortableBadge
+	statisticsKeys []string
 	Sections       []Section
+
+	// Ranking prunes and orders the stack badges, see Rank.
+	Ranking RankOptions
+
+	// Versions decides how versions are displayed on the badges, see Version.
+	Versions VersionOptions
+
+	// Extra holds the badges declared by the user's configuration,
+	// they're always part of the stack.
+	Extra []badge.Badge
+
+	// Warnings collects the problems found while generating the badges
+	// (e.g. logos shields.io doesn't know), they don't stop the generation.
+	Warnings []error
+
+	// BadgeFormat is the markup of ProjectStack and ProjectStatistics,
+	// markdown when empty.
+	BadgeFormat badge.Format
+
+	// current describes the handler being run, so that Set can
+	// record the evidence of the badge it's given.
+	current struct {
+		kind handlerKind
+		path string
+	}
+	evidence map[string]*Evidence
+	observed map[string]map[string]struct{}
+	lines    map[string]int
 }
 
 func (c *Context) StackBadgeKind() BadgeKind {
@@ -74,8 +124,24 @@ func (c *Context) Get(name string) badge.SortableBadge {
 	return c.stack[name]
 }
 
+// Set adds the badge to the stack under name and records the evidence
+// implied by the handler currently running.
 func (c *Context) Set(name string, b badge.SortableBadge) badge.SortableBadge {
 	c.stack[name] = b
+	c.observe(name)
+	return b
+}
+
+// SetStatus adds a status badge (build, coverage, release, ...) under name.
+// Unlike the stack, status badges keep the order they were added in.
+func (c *Context) SetStatus(name string, b badge.SortableBadge) badge.SortableBadge {
+	if c.statistics == nil {
+		c.statistics = make(map[string]badge.SortableBadge)
+	}
+	if _, ok := c.statistics[name]; !ok {
+		c.statisticsKeys = append(c.statisticsKeys, name)
+	}
+	c.statistics[name] = b
 	return b
 }
 
@@ -85,34 +151,61 @@ func (c *Context) generate() {
 		Section{Title: "🚀 Usage", Description: "<!-- description -->"},
 		Section{Title: "✅ Test", Description: "<!-- description -->"})
 
-	var stackPairs []struct {
-		tag   string
-		badge badge.Badge
+	sep := badgeSeparator(c.BadgeFormat)
+	badgeStr := []string{}
+	for _, b := range c.Rank() {
+		c.validate(b.SortableBadge)
+		badgeStr = append(badgeStr, strings.TrimSpace(badge.Render(b, c.BadgeFormat)))
 	}
 
-	for _, b := range c.stack {
-		stackPairs = append(stackPairs, struct {
-			tag   string
-			badge badge.Badge
-		}{b.Tag, b.Badge})
+	c.ProjectStack = strings.Join(badgeStr, sep)
+
+	statusStr := []string{}
+	for _, name := range c.statisticsKeys {
+		b := c.statistics[name]
+		if _, ok := c.Ignore.Badges[b.Name()]; !ok {
+			c.validate(b)
+			statusStr = append(statusStr, strings.TrimSpace(badge.Render(b, c.BadgeFormat)))
+		}
 	}
+	c.ProjectStatistics = strings.Join(statusStr, sep)
+}
 
-	sort.Slice(stackPairs, func(i, j int) bool {
-		return stackPairs[i].tag < stackPairs[j].tag
-	})
+// badgeSeparator returns what goes between two badges of the format:
+// markdown badges stay on the same line, HTML and AsciiDoc ones get a line
+// each, and the RST image directives must be separate blocks.
+func badgeSeparator(f badge.Format) string {
+	switch f {
+	case badge.FormatHTML, badge.FormatAsciiDoc:
+		return "\n"
+	case badge.FormatRST:
+		return "\n\n"
+	}
+	return " "
+}
 
-	badgeStr := []string{}
-	for _, s := range stackPairs {
-		if _, ok := c.Ignore.Badges[s.badge.Name()]; !ok {
-			badgeStr = append(badgeStr, s.badge.Markdown())
+// validate records a warning when the badge declares something
+// its service can't render.
+func (c *Context) validate(b badge.SortableBadge) {
+	if v, ok := b.Badge.(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			c.Warnings = append(c.Warnings, err)
 		}
 	}
-
-	c.ProjectStack = strings.Join(badgeStr, " ")
 }
 
 func Walk(root string, ctx *Context) error {
 	ctx.stack = make(map[string]badge.SortableBadge)
+	if ctx.Ignore == nil {
+		ctx.Ignore = &cfg.DocWizIgnore{}
+	}
+	if ctx.Ignore.Git == nil {
+		ctx.Ignore.Git = &git.GitIgnore{}
+	}
+	for _, b := range ctx.Extra {
+		ctx.stack[b.Name()] = UpgradeBadge("Custom", b)
+		ctx.Observe(b.Name(), Evidence{Declared: true})
+	}
 
 	extHandlers := map[string][]parseHandler{}
 	dirHandlers := map[string][]parseHandler{}
@@ -142,6 +235,7 @@ func Walk(root string, ctx *Context) error {
 		if info.IsDir() {
 			dir := filepath.Base(path)
 			if handlers, ok := dirHandlers[dir]; ok {
+				ctx.enter(handlerKindDir, fullpath)
 				for _, handler := range handlers {
 					handler(fullpath, dir, ctx)
 				}
@@ -151,6 +245,7 @@ func Walk(root string, ctx *Context) error {
 
 		file := filepath.Base(path)
 		if handlers, ok := fileHandlers[file]; ok {
+			ctx.enter(handlerKindFile, fullpath)
 			for _, handler := range handlers {
 				handler(fullpath, file, ctx)
 			}
@@ -158,19 +253,33 @@ func Walk(root string, ctx *Context) error {
 
 		ext := filepath.Ext(path)
 		if handlers, ok := extHandlers[ext]; ok {
+			ctx.enter(handlerKindExt, fullpath)
 			for _, handler := range handlers {
 				handler(fullpath, ext, ctx)
 			}
 		}
 		return nil
 	})
+	ctx.enter(handlerKindNone, "")
 	if err != nil {
 		return err
 	}
+	for _, w := range ctx.Walkers {
+		if f, ok := w.(Finisher); ok {
+			if err := f.Finish(ctx); err != nil {
+				return err
+			}
+		}
+	}
 	ctx.generate()
 	return nil
 }
 
+func (c *Context) enter(kind handlerKind, path string) {
+	c.current.kind = kind
+	c.current.path = path
+}
+
 func UpgradeBadge(tag string, b badge.Badge) badge.SortableBadge {
 	return badge.SortableBadge{Tag: tag, Badge: b}
 }
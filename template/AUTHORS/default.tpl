@@ -1,3 +1,16 @@
+{{- define "person"}}
+- {{if .IsOrganization}}🏢 {{end}}**{{if .HomePage}}[{{.Name}}]({{.HomePage}}){{else}}{{.Name}}{{end}}**
+{{- with .Role}} - {{.}}{{end}}
+{{- with .Org}} ({{.}}){{end}}
+{{- with .Profile}} _{{.}}_{{end}}
+{{- if .Since}} · {{.Since}} → {{.Until | default "present"}}{{else if .Until}} · until {{.Until}}{{end}}
+{{- range $key, $value := .Handles}}
+  - {{$key}}: {{$value}}
+{{- end}}
+{{- range $key, $value := .Others}}
+  - {{$key}}: {{$value}}
+{{- end}}
+{{- end -}}
 # 🎉 Authors & Contributors
 
 This project wouldn't have been possible without the amazing people who have contributed. Here’s a list of those who have helped make this project great!
@@ -9,13 +22,7 @@ This project wouldn't have been possible without the amazing people who have con
 The maintainers are responsible for the overall health of the project, including regular releases, handling issues, and managing pull requests.
 
 {{range .Maintainers}}
-- **{{.Name}}** - Maintainer, {{.Duty}}
-{{- if gt (len .Profile) 0}}
-_{{- .Profile -}}_
-{{- end}}
-{{- range $key, $value := .Others}}
-  - {{$key}}: {{$value}}
-{{- end}}
+{{- template "person" .}}
 {{- end}}
 ---
 
@@ -24,13 +31,7 @@ _{{- .Profile -}}_
 These are the awesome people who have contributed code, features, or bug fixes to this project. Thank you for your hard work and dedication!
 
 {{range .Contributors}}
-- **{{.Name}}** - {{.Duty}}
-{{- if gt (len .Profile) 0}}
-_{{- .Profile -}}_
-{{- end}}
-{{- range $key, $value := .Others}}
-  - {{$key}}: {{$value}}
-{{- end}}
+{{- template "person" .}}
 {{- end}}
 
 ---
@@ -40,13 +41,7 @@ _{{- .Profile -}}_
 A big thank you to those who provided invaluable support and resources for this project. Your contributions go beyond just code!
 
 {{range .SpecialContributors}}
-- **{{.Name}}** - {{.Duty}}
-{{- if gt (len .Profile) 0}}
-_{{- .Profile -}}_
-{{- end}}
-{{- range $key, $value := .Others}}
-  - {{$key}}: {{$value}}
-{{- end}}
+{{- template "person" .}}
 {{- end}}
 
 {{if gt (len .License) 0}}
@@ -1,3 +1,16 @@
+{{- define "person"}}
+- {{if .IsOrganization}}🏢 {{end}}**{{if .HomePage}}[{{.Name}}]({{.HomePage}}){{else}}{{.Name}}{{end}}**
+{{- with .Role}} - {{.}}{{end}}
+{{- with .Org}} ({{.}}){{end}}
+{{- with .Profile}} _{{.}}_{{end}}
+{{- if .Since}} · {{.Since}} → {{.Until | default "至今"}}{{else if .Until}} · 截至 {{.Until}}{{end}}
+{{- range $key, $value := .Handles}}
+  - {{$key}}: {{$value}}
+{{- end}}
+{{- range $key, $value := .Others}}
+  - {{$key}}: {{$value}}
+{{- end}}
+{{- end -}}
 # 🎉 作者与贡献者
 
 [English]() | 简体中文
@@ -11,13 +24,7 @@
 维护者负责项目的整体健康，包括定期发布、处理问题和管理拉取请求。
 
 {{range .Maintainers}}
-- **{{.Name}}** - 维护者，{{.Duty}}
-{{- if gt (len .Profile) 0}}
-_{{- .Profile -}}_
-{{- end}}
-{{- range $key, $value := .Others}}
-  - {{$key}}: {{$value}}
-{{- end}}
+{{- template "person" .}}
 {{- end}}
 ---
 
@@ -26,13 +33,7 @@ _{{- .Profile -}}_
 这些是为这个项目贡献代码、功能或错误修复的了不起的人们。感谢你们的辛勤工作和奉献！
 
 {{range .Contributors}}
-- **{{.Name}}** - {{.Duty}}
-{{- if gt (len .Profile) 0}}
-_{{- .Profile -}}_
-{{- end}}
-{{- range $key, $value := .Others}}
-  - {{$key}}: {{$value}}
-{{- end}}
+{{- template "person" .}}
 {{- end}}
 
 ---
@@ -42,13 +43,7 @@ _{{- .Profile -}}_
 特别感谢那些为本项目提供宝贵支持和资源的人们。你们的贡献不仅仅局限于代码！
 
 {{range .SpecialContributors}}
-- **{{.Name}}** - {{.Duty}}
-{{- if gt (len .Profile) 0}}
-_{{- .Profile -}}_
-{{- end}}
-{{- range $key, $value := .Others}}
-  - {{$key}}: {{$value}}
-{{- end}}
+{{- template "person" .}}
 {{- end}}
 
 {{if gt (len .License) 0}}
@@ -0,0 +1,7 @@
+# 📜 Changelog
+{{ range .Releases }}
+- **{{ .Name | default "Unreleased" }}**
+{{- if .Name }} ({{ .Date | date "2006-01-02" }}){{ end }}:
+{{- range $i, $section := .Sections }}{{ if $i }},{{ end }} {{ $section.Title }} ({{ len $section.Commits }}){{ end }}
+{{- if .CompareURL }} · [compare]({{ .CompareURL }}){{ end }}
+{{- end }}
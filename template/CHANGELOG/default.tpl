@@ -0,0 +1,15 @@
+# 📜 Changelog
+{{- range .Releases }}
+
+## {{ .Name | default "Unreleased" }}
+{{- range .Sections }}
+
+### {{ .Title }}
+{{ range .Commits }}
+- {{ if .Scope }}**{{ .Scope | unescape }}:** {{ end }}{{ .Subject | unescape }} {{ if .URL }}[[{{ .ShortHash }}]({{ .URL }})]{{ else }}[{{ .ShortHash }}]{{ end }}
+{{- if .BreakingNote }}
+{{ .BreakingNote | indent 2 | unescape }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- end }}
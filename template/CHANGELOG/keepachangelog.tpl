@@ -0,0 +1,34 @@
+{{- $categories := dict "Features" "Added" "Bug Fixes" "Fixed" "Performance" "Changed" "Breaking Changes" "Changed" "Other" "Changed" -}}
+# Changelog
+
+All notable changes to this project will be documented in this file.
+
+The format is based on [Keep a Changelog](https://keepachangelog.com/en/1.1.0/),
+and this project adheres to [Semantic Versioning](https://semver.org/spec/v2.0.0.html).
+{{- range $release := .Releases }}
+
+## {{ if .Name }}[{{ .Name }}] - {{ .Date | date "2006-01-02" }}{{ else }}[Unreleased]{{ end }}
+{{- range $category := list "Added" "Changed" "Deprecated" "Removed" "Fixed" "Security" }}
+{{- $entries := list }}
+{{- range $release.Sections }}
+{{- if eq (get $categories .Title | default "Changed") $category }}
+{{- $entries = concat $entries .Commits }}
+{{- end }}
+{{- end }}
+{{- if $entries }}
+
+### {{ $category }}
+{{ range $entries }}
+- {{ if .Breaking }}**BREAKING:** {{ end }}{{ if .Scope }}**{{ .Scope | unescape }}:** {{ end }}{{ .Subject | unescape }}
+{{- if .BreakingNote }}
+{{ .BreakingNote | indent 2 | unescape }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- if .RepositoryURL }}
+{{ range .Releases }}
+[{{ .Name | default "Unreleased" }}]: {{ .CompareURL | default .URL }}
+{{- end }}
+{{- end }}
@@ -0,0 +1,10 @@
+{{- $titles := dict "Features" "新功能" "Bug Fixes" "问题修复" "Performance" "性能优化" "Breaking Changes" "破坏性变更" "Other" "其他" -}}
+# 📜 更新日志
+
+[English]() | 简体中文
+{{ range .Releases }}
+- **{{ .Name | default "未发布" }}**
+{{- if .Name }} ({{ .Date | date "2006-01-02" }}){{ end }}:
+{{- range $i, $section := .Sections }}{{ if $i }}，{{ else }} {{ end }}{{ get $titles $section.Title | default $section.Title }} ({{ len $section.Commits }}){{ end }}
+{{- if .CompareURL }} · [对比]({{ .CompareURL }}){{ end }}
+{{- end }}
@@ -0,0 +1,18 @@
+{{- $titles := dict "Features" "新功能" "Bug Fixes" "问题修复" "Performance" "性能优化" "Breaking Changes" "破坏性变更" "Other" "其他" -}}
+# 📜 更新日志
+
+[English]() | 简体中文
+{{- range .Releases }}
+
+## {{ .Name | default "未发布" }}
+{{- range .Sections }}
+
+### {{ get $titles .Title | default .Title }}
+{{ range .Commits }}
+- {{ if .Scope }}**{{ .Scope | unescape }}:** {{ end }}{{ .Subject | unescape }} {{ if .URL }}[[{{ .ShortHash }}]({{ .URL }})]{{ else }}[{{ .ShortHash }}]{{ end }}
+{{- if .BreakingNote }}
+{{ .BreakingNote | indent 2 | unescape }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- end }}
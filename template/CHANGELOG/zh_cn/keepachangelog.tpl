@@ -0,0 +1,36 @@
+{{- $categories := dict "Features" "新增" "Bug Fixes" "修复" "Performance" "变更" "Breaking Changes" "变更" "Other" "变更" -}}
+# 更新日志
+
+[English]() | 简体中文
+
+本项目的所有重要变更都会记录在此文件中。
+
+格式基于 [Keep a Changelog](https://keepachangelog.com/zh-CN/1.1.0/)，
+并且本项目遵循 [语义化版本](https://semver.org/lang/zh-CN/spec/v2.0.0.html)。
+{{- range $release := .Releases }}
+
+## {{ if .Name }}[{{ .Name }}] - {{ .Date | date "2006-01-02" }}{{ else }}[未发布]{{ end }}
+{{- range $category := list "新增" "变更" "弃用" "移除" "修复" "安全" }}
+{{- $entries := list }}
+{{- range $release.Sections }}
+{{- if eq (get $categories .Title | default "变更") $category }}
+{{- $entries = concat $entries .Commits }}
+{{- end }}
+{{- end }}
+{{- if $entries }}
+
+### {{ $category }}
+{{ range $entries }}
+- {{ if .Breaking }}**破坏性变更:** {{ end }}{{ if .Scope }}**{{ .Scope | unescape }}:** {{ end }}{{ .Subject | unescape }}
+{{- if .BreakingNote }}
+{{ .BreakingNote | indent 2 | unescape }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- if .RepositoryURL }}
+{{ range .Releases }}
+[{{ .Name | default "未发布" }}]: {{ .CompareURL | default .URL }}
+{{- end }}
+{{- end }}
@@ -55,6 +55,12 @@ You can report by:
 
 - **Opening an issue** on the GitHub repository 📂
 - **Contacting us via email**: [{{.Email | default "<!-- email -->" | unescape}}]({{.Email | default "<!-- email -->" | unescape}}) 📧
+{{- with .Contacts}}
+- **Reaching a maintainer** directly 👥
+{{- range .}}
+  - {{.Name}}{{with .Role}}, {{.}}{{end}}{{with .Email}} ({{.}}){{end}}
+{{- end}}
+{{- end}}
 
 We will take all reports seriously and act accordingly.
 
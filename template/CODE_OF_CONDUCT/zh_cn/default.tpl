@@ -56,7 +56,13 @@
 您可以通过以下方式举报：
 
 - **在 GitHub 代码仓库提交 Issue** 📂  
-- **通过电子邮件联系我们**：[<!-- email -->](<!-- email -->) 📧  
+- **通过电子邮件联系我们**：[{{.Email | default "<!-- email -->" | unescape}}]({{.Email | default "<!-- email -->" | unescape}}) 📧  
+{{- with .Contacts}}
+- **直接联系维护者** 👥
+{{- range .}}
+  - {{.Name}}{{with .Role}}，{{.}}{{end}}{{with .Email}}（{{.}}）{{end}}
+{{- end}}
+{{- end}}
 
 我们会**认真对待**所有举报，并采取适当措施。
 
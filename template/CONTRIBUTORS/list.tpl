@@ -0,0 +1,8 @@
+# 👥 Contributors
+
+Thanks to everyone who has contributed to {{ .ProjectName | default "this project" }}!
+{{ range .Contributors }}
+- {{ if .URL }}[{{ .Name }}]({{ .URL }}){{ else }}**{{ .Name }}**{{ end }}{{ with .Person }}{{ with .Role }}, _{{ . }}_{{ end }}{{ with .Org }} ({{ . }}){{ end }}{{ end }}
+{{- if .Commits }} — {{ .Commits }} {{ if eq .Commits 1 }}commit{{ else }}commits{{ end }} (+{{ .Additions }} / -{{ .Deletions }}){{ end }}
+{{- with .Contributions }} {{ range . }}{{ contributionEmoji . }}{{ end }}{{ end }}
+{{- end }}
@@ -0,0 +1,10 @@
+# 👥 Contributors
+
+Thanks to everyone who has contributed to {{ .ProjectName | default "this project" }}!
+
+| Contributor | Commits | Lines | Active | Areas | Contributions |
+| --- | ---: | ---: | --- | --- | --- |
+{{- range .Contributors }}
+| {{ if .Avatar }}<img src="{{ .Avatar }}" width="24" height="24" alt=""> {{ end }}{{ if .URL }}[{{ .Name }}]({{ .URL }}){{ else }}{{ .Name }}{{ end }}{{ with .Person }}{{ with .Role }}, _{{ . }}_{{ end }}{{ with .Org }} ({{ . }}){{ end }}{{ end }}
+{{- if .Commits }} | {{ .Commits }} | +{{ .Additions }} / -{{ .Deletions }} | {{ .First | date "2006-01-02" }} → {{ .Last | date "2006-01-02" }} | {{ join ", " .Areas }}{{ else }} | | | |{{ end }} | {{ range .Contributions }}{{ contributionEmoji . }}{{ end }} |
+{{- end }}
@@ -0,0 +1,22 @@
+# 👥 贡献者
+
+感谢每一位为 {{ .ProjectName | default "本项目" }} 做出贡献的人！
+
+<table>
+  <tbody>
+{{- range $i, $c := .Contributors }}
+{{- if eq (mod $i 7) 0 }}
+    <tr>
+{{- end }}
+      <td align="center" valign="top" width="14.28%">
+        {{- if .URL }}<a href="{{ .URL }}">{{ end }}
+        {{- if .Avatar }}<img src="{{ .Avatar }}" width="100px;" alt="{{ .Name }}"/><br />{{ end }}
+        {{- "" }}<sub><b>{{ .Name }}</b></sub>{{ with .Person }}{{ with .Role }}<br /><sub>{{ . }}</sub>{{ end }}{{ end }}
+        {{- if .URL }}</a>{{ end }}<br />
+        {{- range .Contributions }}{{ contributionEmoji . }}{{ end }}</td>
+{{- if or (eq (mod $i 7) 6) (eq (add1 $i) (len $.Contributors)) }}
+    </tr>
+{{- end }}
+{{- end }}
+  </tbody>
+</table>
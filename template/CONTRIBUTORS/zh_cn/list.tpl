@@ -0,0 +1,8 @@
+# 👥 贡献者
+
+感谢每一位为 {{ .ProjectName | default "本项目" }} 做出贡献的人！
+{{ range .Contributors }}
+- {{ if .URL }}[{{ .Name }}]({{ .URL }}){{ else }}**{{ .Name }}**{{ end }}{{ with .Person }}{{ with .Role }}，_{{ . }}_{{ end }}{{ with .Org }}（{{ . }}）{{ end }}{{ end }}
+{{- if .Commits }} — {{ .Commits }} 次提交（+{{ .Additions }} / -{{ .Deletions }}）{{ end }}
+{{- with .Contributions }} {{ range . }}{{ contributionEmoji . }}{{ end }}{{ end }}
+{{- end }}
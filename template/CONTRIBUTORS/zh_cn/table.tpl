@@ -0,0 +1,10 @@
+# 👥 贡献者
+
+感谢每一位为 {{ .ProjectName | default "本项目" }} 做出贡献的人！
+
+| 贡献者 | 提交 | 代码行 | 活跃时间 | 领域 | 贡献类型 |
+| --- | ---: | ---: | --- | --- | --- |
+{{- range .Contributors }}
+| {{ if .Avatar }}<img src="{{ .Avatar }}" width="24" height="24" alt=""> {{ end }}{{ if .URL }}[{{ .Name }}]({{ .URL }}){{ else }}{{ .Name }}{{ end }}{{ with .Person }}{{ with .Role }}，_{{ . }}_{{ end }}{{ with .Org }}（{{ . }}）{{ end }}{{ end }}
+{{- if .Commits }} | {{ .Commits }} | +{{ .Additions }} / -{{ .Deletions }} | {{ .First | date "2006-01-02" }} → {{ .Last | date "2006-01-02" }} | {{ join ", " .Areas }}{{ else }} | | | |{{ end }} | {{ range .Contributions }}{{ contributionEmoji . }}{{ end }} |
+{{- end }}
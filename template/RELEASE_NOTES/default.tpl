@@ -0,0 +1,36 @@
+## {{ .Release.Name | default "Unreleased" }} ({{ .Release.Date | date "2006-01-02" }})
+{{- with .Highlights }}
+
+### ✨ Highlights
+{{ range . }}
+- {{ if .Scope }}**{{ .Scope | unescape }}:** {{ end }}{{ .Subject | unescape }}
+{{- end }}
+{{- end }}
+{{- with .Breaking }}
+
+### ⚠️ Breaking Changes
+{{ range . }}
+- {{ if .Scope }}**{{ .Scope | unescape }}:** {{ end }}{{ .Subject | unescape }} {{ if .URL }}[[{{ .ShortHash }}]({{ .URL }})]{{ else }}[{{ .ShortHash }}]{{ end }}
+{{- if .BreakingNote }}
+{{ .BreakingNote | indent 2 | unescape }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- range .Release.Sections }}
+
+### {{ .Title }}
+{{ range .Commits }}
+- {{ if .Scope }}**{{ .Scope | unescape }}:** {{ end }}{{ .Subject | unescape }} {{ if .URL }}[[{{ .ShortHash }}]({{ .URL }})]{{ else }}[{{ .ShortHash }}]{{ end }}
+{{- end }}
+{{- end }}
+{{- with .Contributors }}
+
+### 👥 Contributors
+{{ range . }}
+- {{ if .URL }}[{{ .Name }}]({{ .URL }}){{ else }}{{ .Name }}{{ end }} ({{ .Commits }} {{ if eq .Commits 1 }}commit{{ else }}commits{{ end }}){{ if .FirstTime }} 🎉 first contribution{{ end }}
+{{- end }}
+{{- end }}
+{{- with .Release.CompareURL }}
+
+**Full Changelog**: {{ . }}
+{{- end }}
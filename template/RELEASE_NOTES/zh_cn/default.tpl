@@ -0,0 +1,37 @@
+{{- $titles := dict "Breaking Changes" "破坏性变更" "Features" "新功能" "Bug Fixes" "问题修复" "Performance" "性能优化" "Other" "其他" -}}
+## {{ .Release.Name | default "未发布" }} ({{ .Release.Date | date "2006-01-02" }})
+{{- with .Highlights }}
+
+### ✨ 亮点
+{{ range . }}
+- {{ if .Scope }}**{{ .Scope | unescape }}:** {{ end }}{{ .Subject | unescape }}
+{{- end }}
+{{- end }}
+{{- with .Breaking }}
+
+### ⚠️ 破坏性变更
+{{ range . }}
+- {{ if .Scope }}**{{ .Scope | unescape }}:** {{ end }}{{ .Subject | unescape }} {{ if .URL }}[[{{ .ShortHash }}]({{ .URL }})]{{ else }}[{{ .ShortHash }}]{{ end }}
+{{- if .BreakingNote }}
+{{ .BreakingNote | indent 2 | unescape }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- range .Release.Sections }}
+
+### {{ get $titles .Title | default .Title }}
+{{ range .Commits }}
+- {{ if .Scope }}**{{ .Scope | unescape }}:** {{ end }}{{ .Subject | unescape }} {{ if .URL }}[[{{ .ShortHash }}]({{ .URL }})]{{ else }}[{{ .ShortHash }}]{{ end }}
+{{- end }}
+{{- end }}
+{{- with .Contributors }}
+
+### 👥 贡献者
+{{ range . }}
+- {{ if .URL }}[{{ .Name }}]({{ .URL }}){{ else }}{{ .Name }}{{ end }}（{{ .Commits }} 次提交）{{ if .FirstTime }} 🎉 首次贡献{{ end }}
+{{- end }}
+{{- end }}
+{{- with .Release.CompareURL }}
+
+**完整变更**：{{ . }}
+{{- end }}
@@ -0,0 +1,73 @@
+{{- $trend := dict "new" "🆕 new" "up" "📈 up" "down" "📉 down" "steady" "➡️ steady" "inactive" "💤 inactive" -}}
+<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{ .ProjectName }} – Repository Health Report</title>
+<style>
+  body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; max-width: 960px; margin: 2rem auto; padding: 0 1rem; color: #24292f; }
+  table { border-collapse: collapse; width: 100%; margin-bottom: 2rem; }
+  th, td { border-bottom: 1px solid #d0d7de; padding: .4rem .6rem; text-align: left; }
+  td.num, th.num { text-align: right; }
+  code { background: #f6f8fa; padding: .1rem .3rem; border-radius: 4px; }
+  .bar { background: #2da44e; height: .8rem; border-radius: 2px; }
+  .risk { color: #cf222e; font-weight: bold; }
+</style>
+</head>
+<body>
+<h1>🩺 Repository Health Report</h1>
+<p>Health of {{ if .RepositoryURL }}<a href="{{ .RepositoryURL }}">{{ .ProjectName }}</a>{{ else }}{{ .ProjectName }}{{ end }} on {{ .Date | date "2006-01-02" }}: {{ .Commits }} commits, {{ len .Contributors }} contributors and {{ .Files }} files.
+{{- if .Days }} The churn and the bus factors cover the last {{ .Days }} days.{{ end }}</p>
+
+<h2>🧬 Languages</h2>
+<table>
+<tr><th>Language</th><th class="num">Files</th><th class="num">Lines</th><th class="num">Share</th></tr>
+{{- range .Languages }}
+<tr><td>{{ .Name }}</td><td class="num">{{ .Files }}</td><td class="num">{{ .Lines }}</td><td class="num">{{ printf "%.1f" .Share }}%</td></tr>
+{{- end }}
+</table>
+
+<h2>🚌 Bus Factor</h2>
+<p>The bus factor is the number of people owning more than half of a directory, the directories with a bus factor of 1 depend on a single person.</p>
+<table>
+<tr><th>Directory</th><th class="num">Bus factor</th><th>Owners</th></tr>
+{{- range .BusFactors }}
+<tr><td><code>{{ .Path }}</code></td><td class="num{{ if eq .BusFactor 1 }} risk{{ end }}">{{ .BusFactor }}</td><td>{{ join ", " .Owners }}</td></tr>
+{{- end }}
+</table>
+
+<h2>🔥 Churn Hotspots</h2>
+<p>The files changed the most are the likeliest to hide bugs.</p>
+<table>
+<tr><th>File</th><th>Language</th><th class="num">Commits</th><th class="num">Changed lines</th><th class="num">Authors</th></tr>
+{{- range .Hotspots }}
+<tr><td><code>{{ .Path }}</code></td><td>{{ .Language }}</td><td class="num">{{ .Commits }}</td><td class="num">+{{ .Additions }} / -{{ .Deletions }}</td><td class="num">{{ .Authors }}</td></tr>
+{{- end }}
+</table>
+
+<h2>🕰️ Stale Files</h2>
+<table>
+<tr><th>File</th><th>Language</th><th>Created</th><th>Last change</th><th class="num">Age (days)</th></tr>
+{{- range .Stale }}
+<tr><td><code>{{ .Path }}</code></td><td>{{ .Language }}</td><td>{{ .Created | date "2006-01-02" }}</td><td>{{ .Modified | date "2006-01-02" }}</td><td class="num">{{ .Age }}</td></tr>
+{{- end }}
+</table>
+
+<h2>📅 Commit Cadence</h2>
+<table>
+<tr><th>Week</th><th class="num">Commits</th><th class="num">Authors</th><th style="width: 40%"></th></tr>
+{{- range .Weeks }}
+<tr><td>{{ .Start | date "2006-01-02" }}</td><td class="num">{{ .Commits }}</td><td class="num">{{ .Authors }}</td><td><div class="bar" style="width: {{ .Percent }}%"></div></td></tr>
+{{- end }}
+</table>
+
+<h2>👥 Contributor Activity</h2>
+<p>Commits of the last {{ .TrendDays }} days compared to the {{ .TrendDays }} days before.</p>
+<table>
+<tr><th>Contributor</th><th class="num">Recent</th><th class="num">Previous</th><th>Trend</th><th>Last commit</th></tr>
+{{- range .Contributors }}
+<tr><td>{{ .Name }}</td><td class="num">{{ .Recent }}</td><td class="num">{{ .Previous }}</td><td>{{ get $trend .Trend }}</td><td>{{ .Last | date "2006-01-02" }}</td></tr>
+{{- end }}
+</table>
+</body>
+</html>
@@ -0,0 +1,59 @@
+{{- $trend := dict "new" "🆕 new" "up" "📈 up" "down" "📉 down" "steady" "➡️ steady" "inactive" "💤 inactive" -}}
+# 🩺 Repository Health Report
+
+Health of {{ if .RepositoryURL }}[{{ .ProjectName }}]({{ .RepositoryURL }}){{ else }}{{ .ProjectName }}{{ end }} on {{ .Date | date "2006-01-02" }}: {{ .Commits }} commits, {{ len .Contributors }} contributors and {{ .Files }} files.
+{{- if .Days }} The churn and the bus factors cover the last {{ .Days }} days.{{ end }}
+
+## 🧬 Languages
+
+| Language | Files | Lines | Share |
+| --- | ---: | ---: | ---: |
+{{- range .Languages }}
+| {{ .Name }} | {{ .Files }} | {{ .Lines }} | {{ printf "%.1f" .Share }}% |
+{{- end }}
+
+## 🚌 Bus Factor
+
+The bus factor is the number of people owning more than half of a directory, the directories with a bus factor of 1 depend on a single person.
+
+| Directory | Bus factor | Owners |
+| --- | ---: | --- |
+{{- range .BusFactors }}
+| `{{ .Path }}` | {{ if eq .BusFactor 1 }}⚠️ {{ end }}{{ .BusFactor }} | {{ join ", " .Owners }} |
+{{- end }}
+
+## 🔥 Churn Hotspots
+
+The files changed the most are the likeliest to hide bugs.
+
+| File | Language | Commits | Changed lines | Authors |
+| --- | --- | ---: | ---: | ---: |
+{{- range .Hotspots }}
+| `{{ .Path }}` | {{ .Language }} | {{ .Commits }} | +{{ .Additions }} / -{{ .Deletions }} | {{ .Authors }} |
+{{- end }}
+
+## 🕰️ Stale Files
+
+| File | Language | Created | Last change | Age (days) |
+| --- | --- | --- | --- | ---: |
+{{- range .Stale }}
+| `{{ .Path }}` | {{ .Language }} | {{ .Created | date "2006-01-02" }} | {{ .Modified | date "2006-01-02" }} | {{ .Age }} |
+{{- end }}
+
+## 📅 Commit Cadence
+
+| Week | Commits | Authors | |
+| --- | ---: | ---: | --- |
+{{- range .Weeks }}
+| {{ .Start | date "2006-01-02" }} | {{ .Commits }} | {{ .Authors }} | {{ .Bar }} |
+{{- end }}
+
+## 👥 Contributor Activity
+
+Commits of the last {{ .TrendDays }} days compared to the {{ .TrendDays }} days before.
+
+| Contributor | Recent | Previous | Trend | Last commit |
+| --- | ---: | ---: | --- | --- |
+{{- range .Contributors }}
+| {{ .Name }} | {{ .Recent }} | {{ .Previous }} | {{ get $trend .Trend }} | {{ .Last | date "2006-01-02" }} |
+{{- end }}
@@ -0,0 +1,73 @@
+{{- $trend := dict "new" "🆕 新加入" "up" "📈 上升" "down" "📉 下降" "steady" "➡️ 持平" "inactive" "💤 不活跃" -}}
+<!DOCTYPE html>
+<html lang="zh-CN">
+<head>
+<meta charset="utf-8">
+<title>{{ .ProjectName }} – 仓库健康报告</title>
+<style>
+  body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; max-width: 960px; margin: 2rem auto; padding: 0 1rem; color: #24292f; }
+  table { border-collapse: collapse; width: 100%; margin-bottom: 2rem; }
+  th, td { border-bottom: 1px solid #d0d7de; padding: .4rem .6rem; text-align: left; }
+  td.num, th.num { text-align: right; }
+  code { background: #f6f8fa; padding: .1rem .3rem; border-radius: 4px; }
+  .bar { background: #2da44e; height: .8rem; border-radius: 2px; }
+  .risk { color: #cf222e; font-weight: bold; }
+</style>
+</head>
+<body>
+<h1>🩺 仓库健康报告</h1>
+<p>{{ if .RepositoryURL }}<a href="{{ .RepositoryURL }}">{{ .ProjectName }}</a>{{ else }}{{ .ProjectName }}{{ end }} 截至 {{ .Date | date "2006-01-02" }} 的健康状况：{{ .Commits }} 次提交，{{ len .Contributors }} 位贡献者，{{ .Files }} 个文件。
+{{- if .Days }}变更热度与巴士因子统计最近 {{ .Days }} 天。{{ end }}</p>
+
+<h2>🧬 语言</h2>
+<table>
+<tr><th>语言</th><th class="num">文件</th><th class="num">行数</th><th class="num">占比</th></tr>
+{{- range .Languages }}
+<tr><td>{{ .Name }}</td><td class="num">{{ .Files }}</td><td class="num">{{ .Lines }}</td><td class="num">{{ printf "%.1f" .Share }}%</td></tr>
+{{- end }}
+</table>
+
+<h2>🚌 巴士因子</h2>
+<p>巴士因子是拥有目录一半以上内容的人数，巴士因子为 1 的目录依赖于单独一个人。</p>
+<table>
+<tr><th>目录</th><th class="num">巴士因子</th><th>负责人</th></tr>
+{{- range .BusFactors }}
+<tr><td><code>{{ .Path }}</code></td><td class="num{{ if eq .BusFactor 1 }} risk{{ end }}">{{ .BusFactor }}</td><td>{{ join ", " .Owners }}</td></tr>
+{{- end }}
+</table>
+
+<h2>🔥 变更热点</h2>
+<p>修改最频繁的文件最有可能隐藏缺陷。</p>
+<table>
+<tr><th>文件</th><th>语言</th><th class="num">提交</th><th class="num">变更行数</th><th class="num">作者</th></tr>
+{{- range .Hotspots }}
+<tr><td><code>{{ .Path }}</code></td><td>{{ .Language }}</td><td class="num">{{ .Commits }}</td><td class="num">+{{ .Additions }} / -{{ .Deletions }}</td><td class="num">{{ .Authors }}</td></tr>
+{{- end }}
+</table>
+
+<h2>🕰️ 陈旧文件</h2>
+<table>
+<tr><th>文件</th><th>语言</th><th>创建于</th><th>最后修改</th><th class="num">天数</th></tr>
+{{- range .Stale }}
+<tr><td><code>{{ .Path }}</code></td><td>{{ .Language }}</td><td>{{ .Created | date "2006-01-02" }}</td><td>{{ .Modified | date "2006-01-02" }}</td><td class="num">{{ .Age }}</td></tr>
+{{- end }}
+</table>
+
+<h2>📅 提交节奏</h2>
+<table>
+<tr><th>周</th><th class="num">提交</th><th class="num">作者</th><th style="width: 40%"></th></tr>
+{{- range .Weeks }}
+<tr><td>{{ .Start | date "2006-01-02" }}</td><td class="num">{{ .Commits }}</td><td class="num">{{ .Authors }}</td><td><div class="bar" style="width: {{ .Percent }}%"></div></td></tr>
+{{- end }}
+</table>
+
+<h2>👥 贡献者活跃度</h2>
+<p>最近 {{ .TrendDays }} 天与之前 {{ .TrendDays }} 天的提交对比。</p>
+<table>
+<tr><th>贡献者</th><th class="num">最近</th><th class="num">之前</th><th>趋势</th><th>最后提交</th></tr>
+{{- range .Contributors }}
+<tr><td>{{ .Name }}</td><td class="num">{{ .Recent }}</td><td class="num">{{ .Previous }}</td><td>{{ get $trend .Trend }}</td><td>{{ .Last | date "2006-01-02" }}</td></tr>
+{{- end }}
+</table>
+</body>
+</html>
@@ -0,0 +1,59 @@
+{{- $trend := dict "new" "🆕 新加入" "up" "📈 上升" "down" "📉 下降" "steady" "➡️ 持平" "inactive" "💤 不活跃" -}}
+# 🩺 仓库健康报告
+
+{{ if .RepositoryURL }}[{{ .ProjectName }}]({{ .RepositoryURL }}){{ else }}{{ .ProjectName }}{{ end }} 截至 {{ .Date | date "2006-01-02" }} 的健康状况：{{ .Commits }} 次提交，{{ len .Contributors }} 位贡献者，{{ .Files }} 个文件。
+{{- if .Days }}变更热度与巴士因子统计最近 {{ .Days }} 天。{{ end }}
+
+## 🧬 语言
+
+| 语言 | 文件 | 行数 | 占比 |
+| --- | ---: | ---: | ---: |
+{{- range .Languages }}
+| {{ .Name }} | {{ .Files }} | {{ .Lines }} | {{ printf "%.1f" .Share }}% |
+{{- end }}
+
+## 🚌 巴士因子
+
+巴士因子是拥有目录一半以上内容的人数，巴士因子为 1 的目录依赖于单独一个人。
+
+| 目录 | 巴士因子 | 负责人 |
+| --- | ---: | --- |
+{{- range .BusFactors }}
+| `{{ .Path }}` | {{ if eq .BusFactor 1 }}⚠️ {{ end }}{{ .BusFactor }} | {{ join ", " .Owners }} |
+{{- end }}
+
+## 🔥 变更热点
+
+修改最频繁的文件最有可能隐藏缺陷。
+
+| 文件 | 语言 | 提交 | 变更行数 | 作者 |
+| --- | --- | ---: | ---: | ---: |
+{{- range .Hotspots }}
+| `{{ .Path }}` | {{ .Language }} | {{ .Commits }} | +{{ .Additions }} / -{{ .Deletions }} | {{ .Authors }} |
+{{- end }}
+
+## 🕰️ 陈旧文件
+
+| 文件 | 语言 | 创建于 | 最后修改 | 天数 |
+| --- | --- | --- | --- | ---: |
+{{- range .Stale }}
+| `{{ .Path }}` | {{ .Language }} | {{ .Created | date "2006-01-02" }} | {{ .Modified | date "2006-01-02" }} | {{ .Age }} |
+{{- end }}
+
+## 📅 提交节奏
+
+| 周 | 提交 | 作者 | |
+| --- | ---: | ---: | --- |
+{{- range .Weeks }}
+| {{ .Start | date "2006-01-02" }} | {{ .Commits }} | {{ .Authors }} | {{ .Bar }} |
+{{- end }}
+
+## 👥 贡献者活跃度
+
+最近 {{ .TrendDays }} 天与之前 {{ .TrendDays }} 天的提交对比。
+
+| 贡献者 | 最近 | 之前 | 趋势 | 最后提交 |
+| --- | ---: | ---: | --- | --- |
+{{- range .Contributors }}
+| {{ .Name }} | {{ .Recent }} | {{ .Previous }} | {{ get $trend .Trend }} | {{ .Last | date "2006-01-02" }} |
+{{- end }}
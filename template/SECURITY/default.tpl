@@ -27,6 +27,17 @@ If you discover a security vulnerability, we encourage you to responsibly disclo
 
 ---
 
+{{with .Contacts -}}
+## Security Contacts 👥
+
+The following maintainers handle the vulnerability reports:
+{{range .}}
+- **{{.Name}}**{{with .Role}}, {{.}}{{end}}{{with .Email}} ({{.}}){{end}}
+{{- end}}
+
+---
+
+{{end -}}
 ## Security Updates and Patches 🛠️
 
 We are committed to providing timely fixes for any discovered vulnerabilities. Once a security issue is reported and verified, we will:
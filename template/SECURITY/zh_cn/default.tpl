@@ -29,6 +29,17 @@
 
 ---
 
+{{with .Contacts -}}
+## 安全联系人 👥
+
+以下维护者负责处理漏洞报告：
+{{range .}}
+- **{{.Name}}**{{with .Role}}，{{.}}{{end}}{{with .Email}}（{{.}}）{{end}}
+{{- end}}
+
+---
+
+{{end -}}
 ## 安全更新和补丁 🛠️
 
 我们致力于及时修复发现的漏洞。一旦安全问题被报告并验证，我们将：